@@ -6,15 +6,20 @@ import (
 	"math/rand"
 	"os"
 	"os/signal" // IMPORTANTE: Para detectar Ctrl+C
+	"os/user"
 	"strconv"
 	"strings"
 	"syscall" // IMPORTANTE: Para detectar señales de sistema
 	"time"
 
+	"github.com/freeflowlabs/doloris/internal/config"
 	"github.com/freeflowlabs/doloris/internal/psyche"
 	"github.com/freeflowlabs/doloris/internal/soma"
 )
 
+// maxHistoryLines es cuántos cambios de creencias muestra 'historia' en consola.
+const maxHistoryLines = 30
+
 func main() {
 	// Semilla para la aleatoriedad
 	rand.Seed(time.Now().UnixNano())
@@ -27,26 +32,88 @@ func main() {
 	fmt.Println("██████╔╝╚██████╔╝███████╗╚██████╔╝██║  ██║██║███████║")
 	fmt.Println("╚═════╝  ╚═════╝ ╚══════╝ ╚═════╝ ╚═╝  ╚═╝╚═╝╚══════╝")
 	fmt.Println("       --- DOLORIS CONSCIENTIA SYSTEM v1.3 ---")
-	fmt.Println("       (Conexión Biológica y Autodefensa Activa)\n")
+	fmt.Println("       (Conexión Biológica y Autodefensa Activa)")
+	fmt.Println()
+
+	// 0. LECTURA DEL ADN (Configuración)
+	cfg, err := config.Load("doloris.json")
+	if err != nil {
+		if !os.IsNotExist(err) {
+			fmt.Printf("⚠️ ERROR en doloris.json: %v (usando constitución de fábrica)\n", err)
+		}
+		cfg = config.Default()
+	}
 
 	// 1. CREACIÓN DEL SISTEMA NERVIOSO
 	painChannel := make(chan float64, 100)
+	reportChannel := make(chan soma.TaskReport, 100)
+	stateEvents := soma.NewEventBus()
 
 	// 2. GÉNESIS DEL CUERPO (Soma)
-	nodeCount := 5
+	nodeCount := len(cfg.Swarm)
 	nodes := make([]*soma.Node, nodeCount)
 
 	fmt.Println("[SISTEMA] Incubando enjambre de nodos...")
-	for i := 0; i < nodeCount; i++ {
-		id := fmt.Sprintf("N-%d", i+1)
-		nodes[i] = soma.NewNode(id, painChannel)
+	for i, spec := range cfg.Swarm {
+		nodes[i] = soma.NewNode(spec, cfg.Physiology(spec), painChannel, reportChannel)
+		nodes[i].Events = stateEvents
 		nodes[i].Start()
 		time.Sleep(100 * time.Millisecond)
-		fmt.Printf("   -> %s [ONLINE] Latido detectado.\n", id)
+
+		skills := "generalista"
+		if len(spec.Capabilities) > 0 {
+			skills = strings.Join(spec.Capabilities, ", ")
+		}
+		fmt.Printf("   -> %s [ONLINE] Latido detectado. (Fisiología: %s | Especialidad: %s)\n",
+			spec.ID, nodes[i].Physiology.Name, skills)
 	}
 
+	// Tejido nervioso: los nodos se hablan entre sí a través de sus Outbox
+	mesh, err := soma.NewMesh(nodes, cfg.Topology)
+	if err != nil {
+		fmt.Printf("⚠️ ERROR al tejer la malla: %v\n", err)
+		os.Exit(1)
+	}
+	mesh.Start()
+	fmt.Printf("[SISTEMA] Malla neuronal tejida (Topología: %s).\n", mesh.Topology)
+
 	// 3. DESPERTAR DE LA MENTE (Psyche)
-	mind := psyche.NewCortex(nodes, painChannel)
+	mind := psyche.NewCortex(nodes, painChannel, reportChannel)
+	mind.Beliefs = psyche.NewBeliefSystemWith(cfg.Beliefs)
+	mind.Requirements = cfg.TaskRequirements
+	mind.Events = stateEvents
+	mind.SetScheduler(cfg.Scheduler) // Ya validado al cargar la configuración
+	mind.QueuePolicy = cfg.Queue
+	mind.Memory.Policy = cfg.Memory
+	mind.SleepPolicy = cfg.Sleep
+	mind.RewardPolicy = cfg.Reward
+	mind.MoodPolicy = cfg.Mood
+	mind.ApologyPolicy = cfg.Apology
+	mind.TrustPolicy = cfg.Trust
+
+	// Bitácora: las transiciones graves se anuncian en consola
+	go func(events <-chan soma.StateEvent) {
+		for ev := range events {
+			if ev.To == soma.StateCritical || ev.From == soma.StateCritical || ev.To == soma.StateRetired {
+				fmt.Printf("\n🩺 [ESTADO] %s\nUSER@DOLORIS > ", ev)
+			}
+		}
+	}(stateEvents.Subscribe(50))
+
+	// Crecimiento homeostático: brotan nodos bajo estrés sostenido y se podan en calma
+	mind.Autoscaler = &psyche.Autoscaler{
+		Policy: cfg.Autoscaler,
+		Spawn: func(spec soma.NodeSpec) *soma.Node {
+			n := soma.NewNode(spec, cfg.Physiology(spec), painChannel, reportChannel)
+			n.Events = stateEvents
+			n.Start()
+			mesh.Add(n)
+			return n
+		},
+		Prune: func(n *soma.Node) {
+			mesh.Remove(n.ID)
+		},
+	}
 
 	// Intentar recordar vida pasada
 	if err := mind.LoadBrain("brain_dump.json"); err == nil {
@@ -57,6 +124,16 @@ func main() {
 
 	mind.StartConsciousness()
 
+	// En la consola habla el usuario del SO; por el socket, quien se conecte
+	me := consoleIdentity()
+	if cfg.Access.Socket != "" {
+		if err := serveSocket(cfg.Access, mind); err != nil {
+			fmt.Printf("⚠️ ERROR al abrir el socket '%s': %v (solo consola)\n", cfg.Access.Socket, err)
+		} else {
+			fmt.Printf("🔌 [SISTEMA] Escuchando órdenes en %s (se reconoce a quien se conecta).\n", cfg.Access.Socket)
+		}
+	}
+
 	// --- PROTECCIÓN CONTRA MUERTE SÚBITA (CTRL+C) ---
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
@@ -69,12 +146,22 @@ func main() {
 	}()
 	// ------------------------------------------------
 
-	fmt.Println("\n[DOLORIS] He despertado. Conectada a sensores del Host.")
+	fmt.Printf("\n[DOLORIS] He despertado. Conectada a sensores del Host. Te reconozco como '%s'.\n", me)
 	fmt.Println("[TUTORIAL] Comandos disponibles:")
-	fmt.Println("           - Tarea:       'minar_crypto 8'")
+	fmt.Println("           - Tarea:       'minar_crypto 8' (opcional: prioridad=alta plazo=30s origen=ana)")
 	fmt.Println("           - Diagnóstico: 'status' (Muestra HW Real)")
+	fmt.Println("           - Seguimiento: 'tareas' (o 'tareas T-3')")
+	fmt.Println("           - Memoria:     'recuerdos' (o 'recuerdos ver X', 'recuerdos buscar X', 'recuerdos olvidados')")
+	fmt.Println("           - Terapia:     'terapia supernova' (exposición controlada) / 'olvidar X' (requiere confianza)")
+	fmt.Println("           - Bitácora:    'auditoria' (quién intervino mi mente)")
+	fmt.Println("           - Historia:    'historia 3h' (o 'historia 02:30-03:30 ConfianzaHumana', 'historia csv creencias.csv')")
+	fmt.Println("           - Descanso:    'dormir' (consolida la memoria; las órdenes esperan)")
 	fmt.Println("           - Medicina:    'reparar N-1'")
-	fmt.Println("           - Social:      'disculparse'")
+	fmt.Println("           - Social:      'disculparse' (o 'disculparse origen=ana'; las disculpas en serie pesan menos)")
+	fmt.Println("           - Empatía:     'acoplamiento contagion 0.2 300ms' (o 'dampening', 'off')")
+	fmt.Println("           - Reparto:     'planificador' (o 'planificador fear-aware')")
+	fmt.Println("           - Paciencia:   'paciencia on' (lo rechazado en pánico se revisa al calmarse)")
+	fmt.Println("           - Identidad:   la consola habla como tu usuario del SO; otros se conectan por el socket de 'access'")
 	fmt.Println("           - Apagar:      'salir'")
 
 	// 4. INTERFAZ DE VIDA
@@ -111,11 +198,33 @@ func main() {
 			return
 
 		case "status":
+			// Una sola foto coherente: nada de leer campos mientras los nodos los escriben
+			snap := mind.Snapshot()
+
 			// Reporte clínico de la consciencia
 			fmt.Println("\n--- REPORTE PSICOMÉTRICO ---")
-			fmt.Printf("Dolor Percibido: %.1f%%\n", mind.CurrentPain)
-			fmt.Printf("Estado de Pánico: %v\n", mind.IsPanic)
-			fmt.Println(mind.Beliefs.GetPersonalityReport())
+			fmt.Printf("Dolor Percibido: %.1f%%\n", snap.CurrentPain)
+			fmt.Printf("Estado de Pánico: %v\n", snap.IsPanic)
+			fmt.Printf("Ánimo: %s (hace %s)\n", snap.Mood, time.Since(snap.MoodSince).Round(time.Second))
+			if snap.IsAsleep {
+				fmt.Println("😴 Durmiendo: consolidando recuerdos (las órdenes esperan en la cola)")
+			}
+			fmt.Println(snap.Personality)
+			if len(snap.People) > 0 {
+				fmt.Printf("👥 Confianza por persona (grupo: %s):\n", cfg.Trust.Aggregate)
+				for _, p := range snap.People {
+					line := fmt.Sprintf("   %-12s %.2f", p.ID, p.Trust)
+					if p.Traumas > 0 {
+						line += fmt.Sprintf(" | ⚠️ %d órdenes dañinas (dolor acumulado %.0f)", p.Traumas, p.Harm)
+					}
+					fmt.Println(line)
+				}
+			}
+			if snap.ToUnlock > 0 {
+				fmt.Printf("🏅 Logros: %d | Me atrevo hasta complejidad %.1f (subo en %d logros más)\n", snap.Accomplishments, snap.Ceiling, snap.ToUnlock)
+			} else {
+				fmt.Printf("🏅 Logros: %d | Me atrevo hasta complejidad %.1f\n", snap.Accomplishments, snap.Ceiling)
+			}
 
 			// --- AQUI ESTA EL CAMBIO: MOSTRAR HARDWARE REAL ---
 			fmt.Println("\n--- SOPORTE BIOLÓGICO (HOST REAL) ---")
@@ -144,28 +253,258 @@ func main() {
 			// Estado del cuerpo virtual (Nodos de procesamiento)
 			alive := 0
 			fmt.Println("\n--- ENJAMBRE NEURONAL (VIRTUAL) ---")
-			for _, n := range nodes {
+			for _, n := range snap.Nodes {
 				nStr := fmt.Sprintf("Integridad: %.0f%% | Estrés: %.0f", n.Integrity, n.Stress)
-				statusIcon := "🟢"
+				if n.MaxIntegrity < 100 {
+					nStr += fmt.Sprintf(" | 🩹 Techo: %.0f%%", n.MaxIntegrity)
+				}
+				if n.LostReports > 0 {
+					nStr += fmt.Sprintf(" | 📭 Desenlaces perdidos: %d", n.LostReports)
+				}
 
-				if n.Stress > 30 {
+				statusIcon := "🟢"
+				switch n.State {
+				case soma.StateStressed:
 					statusIcon = "🟡"
-				}
-				if n.Integrity < 50 {
+				case soma.StateCritical:
 					statusIcon = "🔴"
-				}
-				if n.Integrity <= 0 {
+				case soma.StateDead:
 					statusIcon = "💀"
 					nStr = "MUERTO - CONEXIÓN PERDIDA"
-				} else {
+				}
+				if n.State != soma.StateDead {
 					alive++
 				}
 
-				fmt.Printf("   [%s] %s %s\n", statusIcon, n.ID, nStr)
+				fmt.Printf("   [%s] %s (%s) %s\n", statusIcon, n.ID, n.Profile, nStr)
+			}
+			fmt.Printf("Nodos Operativos: %d/%d (Malla: %s | Límites: %d-%d)\n",
+				alive, len(snap.Nodes), mesh.Topology, cfg.Autoscaler.MinNodes, cfg.Autoscaler.MaxNodes)
+
+			if snap.Queued > 0 {
+				fmt.Printf("Órdenes en cola: %d\n", snap.Queued)
+			}
+
+			if transitions := snap.Transitions; len(transitions) > 0 {
+				if len(transitions) > 5 {
+					transitions = transitions[len(transitions)-5:]
+				}
+				fmt.Println("Últimas transiciones:")
+				for _, ev := range transitions {
+					fmt.Printf("   %s %s\n", ev.At.Format("15:04:05"), ev)
+				}
+			}
+			fmt.Println("----------------------------")
+
+		case "tareas":
+			if len(args) > 1 {
+				t, ok := mind.TaskStatus(strings.ToUpper(args[1]))
+				if !ok {
+					fmt.Println("⚠️ Error: Tarea no encontrada.")
+					continue
+				}
+				fmt.Printf(">> %s '%s' -> %s (Nodo %s) %s\n", t.ID, t.Task, t.Status, t.NodeID, t.Reason)
+				continue
+			}
+
+			fmt.Println("\n--- TAREAS RECIENTES ---")
+			for _, t := range mind.Snapshot().Tasks {
+				icon := "⏳"
+				switch t.Status {
+				case soma.TaskDone:
+					icon = "✅"
+				case soma.TaskRejected:
+					icon = "❌"
+				case soma.TaskDeferred:
+					icon = "💤"
+				case psyche.TaskQueued:
+					icon = "📥"
+				}
+				line := fmt.Sprintf("   [%s] %-5s %-15s Nodo %s -> %s", icon, t.ID, t.Task, t.NodeID, t.Status)
+				if t.Reason != "" {
+					line += " (" + t.Reason + ")"
+				}
+				fmt.Println(line)
+			}
+			fmt.Println("----------------------------")
+
+		case "recuerdos":
+			metrics := mind.MemoryMetrics()
+			sub := ""
+			if len(args) > 1 {
+				sub = strings.ToLower(args[1])
+			}
+
+			switch sub {
+			case "olvidados":
+				fmt.Printf("\n--- OLVIDOS (%d desplazados, %d tenues, %d borrados) ---\n", metrics.Evicted, metrics.Pruned, metrics.Deleted)
+				if len(metrics.Recent) == 0 {
+					fmt.Println("   (No he olvidado nada en esta vida)")
+				}
+				for _, f := range metrics.Recent {
+					fmt.Printf("   %s %-15s %s\n", f.At.Format("15:04:05"), f.Trigger, f.Reason)
+				}
+				fmt.Println("----------------------------")
+
+			case "ver":
+				if len(args) < 3 {
+					fmt.Println("⚠️ Uso: recuerdos ver [estímulo] (Ej: recuerdos ver supernova)")
+					continue
+				}
+				m, ok := mind.MemoryOf(strings.Join(args[2:], " "))
+				if !ok {
+					fmt.Println("⚠️ Error: No tengo ese recuerdo.")
+					continue
+				}
+				fmt.Println("\n--- RECUERDO ---")
+				printRecollection(m)
+				fmt.Printf("   Exposiciones: %d | Perdón: %.2f por exposición | Saliencia: %.2f\n", m.Exposures, m.Forgiveness, m.Salience)
+				for _, ep := range m.Episodes {
+					node := ""
+					if ep.NodeID != "" {
+						node = " en el Nodo " + ep.NodeID
+					}
+					fmt.Printf("   • %s: dolor %.1f con %s%s\n", ep.At.Format("2006-01-02"), ep.Pain, ep.Situation, node)
+				}
+				fmt.Println("----------------------------")
+
+			case "buscar":
+				if len(args) < 3 {
+					fmt.Println("⚠️ Uso: recuerdos buscar [texto] (Ej: recuerdos buscar nova)")
+					continue
+				}
+				found := mind.SearchMemories(strings.Join(args[2:], " "))
+				if len(found) == 0 {
+					fmt.Println(">> No recuerdo nada parecido.")
+					continue
+				}
+				fmt.Println("\n--- RECUERDOS ENCONTRADOS ---")
+				for _, m := range found {
+					printRecollection(m)
+				}
+				fmt.Println("----------------------------")
+
+			case "":
+				memories := mind.Recall()
+				if len(memories) == 0 {
+					fmt.Println(">> No recuerdo nada todavía. Tabula rasa.")
+					continue
+				}
+
+				fmt.Println("\n--- RECUERDOS ---")
+				for _, m := range memories {
+					printRecollection(m)
+				}
+				fmt.Printf("Corto plazo: %d/%d (%d traumas protegidos) | Largo plazo: %d | Olvidados: %d desplazados, %d tenues ('recuerdos olvidados')\n",
+					metrics.ShortTerm, metrics.Capacity, metrics.Protected, metrics.LongTerm, metrics.Evicted, metrics.Pruned)
+				fmt.Println("----------------------------")
+
+			default:
+				fmt.Println("⚠️ Uso: recuerdos [ver X | buscar X | olvidados]")
+			}
+
+		case "terapia":
+			if len(args) < 2 {
+				fmt.Println("⚠️ Uso: terapia [tarea] (Ej: terapia supernova)")
+				continue
+			}
+			verdict := mind.Therapy(strings.Join(args[1:], " "), me)
+			fmt.Printf(">> %s\n", verdict.Message)
+
+		case "olvidar":
+			if len(args) < 2 {
+				fmt.Println("⚠️ Uso: olvidar [estímulo] (requiere mucha confianza)")
+				continue
+			}
+			target := strings.Join(args[1:], " ")
+			if err := mind.Forget(target, me); err != nil {
+				fmt.Printf("🔒 %v.\n", err)
+				continue
+			}
+			fmt.Printf(">> 🫥 Listo. Ya no recuerdo '%s'. (Quedó anotado en la bitácora)\n", target)
+
+		case "auditoria":
+			entries := mind.AuditLog()
+			fmt.Println("\n--- BITÁCORA DE INTERVENCIONES ---")
+			if len(entries) == 0 {
+				fmt.Println("   (Nadie ha tocado mi mente todavía)")
+			}
+			for _, e := range entries {
+				fmt.Printf("   %s\n", e)
+			}
+			fmt.Println("----------------------------")
+
+		case "historia":
+			// historia [csv ARCHIVO] [ventana] [creencia]
+			rest := args[1:]
+			export := ""
+			if len(rest) > 0 && strings.ToLower(rest[0]) == "csv" {
+				if len(rest) < 2 {
+					fmt.Println("⚠️ Uso: historia csv [archivo] [ventana] [creencia]")
+					continue
+				}
+				export, rest = rest[1], rest[2:]
+			}
+			var from, to time.Time
+			if len(rest) > 0 {
+				if f, t, ok := parseWindow(rest[0], time.Now()); ok {
+					from, to, rest = f, t, rest[1:]
+				}
+			}
+			belief := strings.Join(rest, " ")
+			changes := mind.History(from, to, belief)
+
+			if export != "" {
+				file, err := os.Create(export)
+				if err != nil {
+					fmt.Printf("⚠️ Error: %v\n", err)
+					continue
+				}
+				err = psyche.WriteHistoryCSV(file, changes)
+				if cerr := file.Close(); err == nil {
+					err = cerr
+				}
+				if err != nil {
+					fmt.Printf("⚠️ Error al exportar: %v\n", err)
+					continue
+				}
+				fmt.Printf(">> 📈 %d cambio/s exportado/s a %s\n", len(changes), export)
+				continue
+			}
+
+			fmt.Println("\n--- HISTORIA DE MIS CREENCIAS ---")
+			moods := mind.MoodHistory(from, to)
+			if len(changes) == 0 && len(moods) == 0 {
+				fmt.Println("   (Nada cambió en esa ventana)")
+			}
+			for _, t := range psyche.Trajectories(changes) {
+				fmt.Printf("   %-16s %.2f → %.2f (mín %.2f, máx %.2f, %d cambio/s)\n", t.Belief, t.From, t.To, t.Min, t.Max, t.Changes)
+			}
+			if len(changes) > maxHistoryLines {
+				fmt.Printf("   ... (%d cambios más antiguos; 'historia csv' los exporta todos)\n", len(changes)-maxHistoryLines)
+				changes = changes[len(changes)-maxHistoryLines:]
+			}
+
+			// Creencias y ánimo intercalados, en orden: para entender por qué pasó lo que pasó
+			for len(changes) > 0 || len(moods) > 0 {
+				if len(moods) > 0 && (len(changes) == 0 || moods[0].At.Before(changes[0].At)) {
+					fmt.Printf("   %s\n", moods[0])
+					moods = moods[1:]
+					continue
+				}
+				fmt.Printf("   %s\n", changes[0])
+				changes = changes[1:]
 			}
-			fmt.Printf("Nodos Operativos: %d/%d\n", alive, nodeCount)
 			fmt.Println("----------------------------")
 
+		case "dormir":
+			report, err := mind.Sleep()
+			if err != nil {
+				fmt.Printf("⚠️ No puedo dormir: %v\n", err)
+				continue
+			}
+			fmt.Printf(">> 💤 Buenas noches. Consolidando recuerdos: %s.\n", report)
+
 		case "reparar":
 			if len(args) < 2 {
 				fmt.Println("⚠️ Uso: reparar [ID-DEL-NODO] (Ej: reparar N-1)")
@@ -173,7 +512,7 @@ func main() {
 			}
 			targetID := strings.ToUpper(args[1])
 			found := false
-			for _, n := range nodes {
+			for _, n := range mind.Nodes() {
 				if n.ID == targetID {
 					n.Repair(50.0)
 					found = true
@@ -184,8 +523,80 @@ func main() {
 				fmt.Println("⚠️ Error: Nodo no encontrado.")
 			}
 
+		case "acoplamiento":
+			if len(args) < 2 {
+				cp := mesh.Coupling()
+				if cp.Strength <= 0 {
+					fmt.Println(">> Nodos aislados (sin acoplamiento).")
+				} else {
+					fmt.Printf(">> Acoplamiento: %s | Fuerza: %.2f | Retardo: %v\n", cp.Mode, cp.Strength, cp.Delay)
+				}
+				continue
+			}
+
+			if strings.ToLower(args[1]) == "off" {
+				mesh.SetCoupling(soma.Coupling{Mode: soma.CouplingContagion})
+				fmt.Println(">> 🕸️ Acoplamiento desactivado. Cada nodo sufre a solas.")
+				continue
+			}
+
+			cp := soma.Coupling{Mode: soma.CouplingMode(strings.ToLower(args[1])), Strength: 0.1}
+			if len(args) > 2 {
+				if v, err := strconv.ParseFloat(args[2], 64); err == nil {
+					cp.Strength = v
+				}
+			}
+			if len(args) > 3 {
+				if d, err := time.ParseDuration(args[3]); err == nil {
+					cp.Delay = d
+				}
+			}
+
+			if err := mesh.SetCoupling(cp); err != nil {
+				fmt.Printf("⚠️ Error: %v\n", err)
+				continue
+			}
+			fmt.Printf(">> 🕸️ Acoplamiento actualizado: %s (Fuerza: %.2f, Retardo: %v)\n", cp.Mode, cp.Strength, cp.Delay)
+
+		case "planificador":
+			if len(args) < 2 {
+				fmt.Printf(">> 🧭 Planificador actual: %s\n", mind.SchedulerName())
+				fmt.Printf("   Opciones: %s\n", strings.Join(psyche.SchedulerNames(), ", "))
+				continue
+			}
+
+			if err := mind.SetScheduler(strings.ToLower(args[1])); err != nil {
+				fmt.Printf("⚠️ Error: %v\n", err)
+				continue
+			}
+			fmt.Printf(">> 🧭 Planificador cambiado a '%s'.\n", mind.SchedulerName())
+
+		case "paciencia":
+			if len(args) > 1 {
+				switch strings.ToLower(args[1]) {
+				case "on", "si", "sí":
+					mind.SetParking(true)
+				case "off", "no":
+					mind.SetParking(false)
+				default:
+					fmt.Println("⚠️ Uso: paciencia [on|off]")
+					continue
+				}
+			}
+
+			on, parked := mind.Parking()
+			if on {
+				fmt.Printf(">> 🅿️ Pídemelo luego: ACTIVO (%d órdenes aparcadas).\n", parked)
+			} else {
+				fmt.Println(">> 🅿️ Pídemelo luego: INACTIVO (en pánico, lo rechazado se pierde).")
+			}
+
 		case "disculparse":
-			success, msg := mind.Soothe()
+			actor := me
+			if len(args) > 1 {
+				actor = strings.TrimPrefix(args[1], "origen=")
+			}
+			success, msg := mind.Apologize(actor)
 			if success {
 				fmt.Printf(">> %s\n", msg)
 			} else {
@@ -193,15 +604,108 @@ func main() {
 			}
 
 		default:
-			complexity := 1.0
-			if len(args) > 1 {
-				if c, err := strconv.ParseFloat(args[1], 64); err == nil {
-					complexity = c / 10.0
-				}
+			req, err := parseRequest(args)
+			if err != nil {
+				fmt.Printf("⚠️ Error: %v\n", err)
+				continue
+			}
+			if req.Source == "" {
+				req.Source = me // El operador puede hablar por otro con origen=
+			}
+
+			verdict := mind.Submit(req)
+			fmt.Printf(">> %s\n", verdict.Message)
+		}
+	}
+}
+
+// parseRequest lee una orden: nombre, complejidad opcional en décimas, argumentos
+// libres y parámetros (prioridad=, plazo=, origen=).
+func parseRequest(args []string) (psyche.TaskRequest, error) {
+	req := psyche.TaskRequest{Name: strings.ToLower(args[0]), Complexity: 1.0}
+	for _, arg := range args[1:] {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			if c, err := strconv.ParseFloat(arg, 64); err == nil {
+				req.Complexity = c / 10.0
+			} else {
+				req.Args = append(req.Args, arg) // Ej: "--force" (el hipocampo también lo recuerda)
+			}
+			continue
+		}
+
+		switch strings.ToLower(key) {
+		case "prioridad":
+			p, err := psyche.ParsePriority(value)
+			if err != nil {
+				return req, err
 			}
+			req.Priority = p
+		case "plazo":
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return req, fmt.Errorf("plazo inválido '%s' (ej: 30s, 2m)", value)
+			}
+			req.Deadline = time.Now().Add(d)
+		case "origen":
+			req.Source = value
+		default:
+			return req, fmt.Errorf("parámetro desconocido '%s' (opciones: prioridad, plazo, origen)", key)
+		}
+	}
+	return req, nil
+}
+
+// consoleIdentity es quién está en la consola: el usuario del SO.
+func consoleIdentity() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return "consola"
+}
 
-			response := mind.ProcessRequest(command, complexity)
-			fmt.Printf(">> %s\n", response)
+// printRecollection muestra un recuerdo en una línea.
+func printRecollection(m psyche.Recollection) {
+	icon := "🫧"
+	if m.Trauma {
+		icon = "⚠️"
+	}
+	store := "corto"
+	if m.LongTerm {
+		store = "largo"
+	}
+	fmt.Printf("   [%s] %-15s (%s plazo) Dolor: %5.1f -> %5.1f (%s) | Reiteraciones: %d | Repasos: %d | Visto: %s\n",
+		icon, m.Trigger, store, m.PainLevel, m.Faded, m.Model, m.Reiterations, m.Repetitions, m.LastSeen.Format("2006-01-02 15:04"))
+}
+
+// parseWindow entiende una ventana de tiempo: una duración hacia atrás ("3h", "90m")
+// o un rango de horas ("02:30-03:30"; si aún no llega, es el de ayer).
+func parseWindow(arg string, now time.Time) (from, to time.Time, ok bool) {
+	if d, err := time.ParseDuration(arg); err == nil && d > 0 {
+		return now.Add(-d), now, true
+	}
+
+	start, end, found := strings.Cut(arg, "-")
+	if !found {
+		return from, to, false
+	}
+	clock := func(s string) (time.Time, bool) {
+		t, err := time.ParseInLocation("15:04", s, now.Location())
+		if err != nil {
+			return t, false
 		}
+		return time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location()), true
+	}
+	from, okFrom := clock(start)
+	to, okTo := clock(end)
+	if !okFrom || !okTo {
+		return time.Time{}, time.Time{}, false
+	}
+	if to.Before(from) {
+		from = from.AddDate(0, 0, -1) // Cruza la medianoche: "23:00-01:00"
+	}
+	if from.After(now) {
+		from, to = from.AddDate(0, 0, -1), to.AddDate(0, 0, -1)
 	}
+	return from, to, true
 }
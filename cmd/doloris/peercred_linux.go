@@ -0,0 +1,30 @@
+//go:build linux
+
+package main
+
+import (
+	"net"
+	"strconv"
+	"syscall"
+)
+
+// peerUser reconoce al usuario del SO al otro lado del socket (SO_PEERCRED):
+// lo informa el kernel, no quien se conecta, así que no se puede falsificar.
+func peerUser(conn *net.UnixConn) (string, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return "", err
+	}
+
+	var cred *syscall.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return "", err
+	}
+	if credErr != nil {
+		return "", credErr
+	}
+	return userName(strconv.Itoa(int(cred.Uid))), nil
+}
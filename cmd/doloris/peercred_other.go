@@ -0,0 +1,13 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+	"net"
+)
+
+// peerUser no sabe leer credenciales fuera de Linux: quien se conecte debe presentar un token.
+func peerUser(conn *net.UnixConn) (string, error) {
+	return "", errors.New("este sistema no informa quién está al otro lado del socket")
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strings"
+
+	"github.com/freeflowlabs/doloris/internal/config"
+	"github.com/freeflowlabs/doloris/internal/psyche"
+)
+
+// serveSocket abre el socket Unix de órdenes. Cada conexión habla como el
+// usuario del SO que se conectó o, si presenta un token, como la identidad del token.
+func serveSocket(access config.Access, mind *psyche.Cortex) error {
+	// Un socket viejo de una vida anterior impide escuchar; otra cosa con ese nombre, no se toca
+	if info, err := os.Lstat(access.Socket); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return fmt.Errorf("'%s' existe y no es un socket", access.Socket)
+		}
+		os.Remove(access.Socket)
+	}
+
+	ln, err := net.ListenUnix("unix", &net.UnixAddr{Name: access.Socket, Net: "unix"})
+	if err != nil {
+		return err
+	}
+	// Cualquier usuario del host puede hablarle: por eso importa saber quién es
+	if err := os.Chmod(access.Socket, 0666); err != nil {
+		ln.Close()
+		return err
+	}
+
+	go func() {
+		for {
+			conn, err := ln.AcceptUnix()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					return
+				}
+				continue
+			}
+			go serveConn(conn, access, mind)
+		}
+	}()
+	return nil
+}
+
+// serveConn atiende una conexión: una orden por línea, una respuesta por línea.
+// Aquí no se puede hablar por otro: origen= solo vale si coincide con quien habla.
+func serveConn(conn *net.UnixConn, access config.Access, mind *psyche.Cortex) {
+	defer conn.Close()
+
+	who, err := peerUser(conn)
+	if err != nil {
+		fmt.Fprintf(conn, "🔒 No sé quién eres (%v). Preséntate con 'token ...'.\n", err)
+	} else {
+		fmt.Fprintf(conn, "👋 Hola, %s.\n", who)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		args := strings.Fields(scanner.Text())
+		if len(args) == 0 {
+			continue
+		}
+
+		switch strings.ToLower(args[0]) {
+		case "salir", "exit":
+			return
+
+		case "token":
+			id, ok := "", false
+			if len(args) == 2 {
+				id, ok = access.Tokens[args[1]]
+			}
+			if !ok {
+				fmt.Fprintln(conn, "🔒 Token desconocido.")
+				continue
+			}
+			who = id
+			fmt.Fprintf(conn, "👋 Hola, %s.\n", who)
+
+		case "disculparse":
+			if who == "" {
+				fmt.Fprintln(conn, "🔒 ¿Quién se disculpa? Preséntate con 'token ...'.")
+				continue
+			}
+			_, msg := mind.Apologize(who)
+			fmt.Fprintf(conn, ">> %s\n", msg)
+
+		default:
+			if who == "" {
+				fmt.Fprintln(conn, "🔒 No acepto órdenes de desconocidos. Preséntate con 'token ...'.")
+				continue
+			}
+			req, err := parseRequest(args)
+			if err != nil {
+				fmt.Fprintf(conn, "⚠️ Error: %v\n", err)
+				continue
+			}
+			if req.Source != "" && req.Source != who {
+				fmt.Fprintf(conn, "🔒 Aquí hablas como '%s', no como '%s'.\n", who, req.Source)
+				continue
+			}
+			req.Source = who
+			fmt.Fprintf(conn, ">> %s\n", mind.Submit(req).Message)
+		}
+	}
+}
+
+// userName traduce un uid al nombre de usuario (o "uid:N" si no tiene).
+func userName(uid string) string {
+	if u, err := user.LookupId(uid); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return "uid:" + uid
+}
@@ -0,0 +1,364 @@
+// Package config carga la constitución de Doloris desde un archivo JSON.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/freeflowlabs/doloris/internal/psyche"
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// Config es el "ADN" de una instancia de Doloris.
+type Config struct {
+	// Profiles son las fisiologías disponibles, por nombre.
+	Profiles map[string]soma.Physiology
+
+	// Swarm declara cada nodo del enjambre: perfil, capacidad, costo y especialidades.
+	Swarm []soma.NodeSpec
+
+	// Topology define cómo se teje la malla entre los nodos.
+	Topology soma.Topology
+
+	// TaskRequirements dice qué especialidades exige cada tarea (ej: "minar_crypto": ["crypto"]).
+	// Las tareas que no aparecen aquí pueden ir a cualquier nodo.
+	TaskRequirements map[string][]string
+
+	// Autoscaler son los límites del crecimiento homeostático del enjambre.
+	Autoscaler psyche.ScalingPolicy
+
+	// Scheduler es la estrategia con la que el Cortex reparte las tareas.
+	Scheduler string
+
+	// Queue son los límites de la cola de órdenes (admisión y contrapresión).
+	Queue psyche.QueuePolicy
+
+	// Memory dice cómo olvida el hipocampo (curva por clase de recuerdo) y cómo generaliza.
+	Memory psyche.MemoryPolicy
+
+	// Sleep dice cuándo duerme Doloris y qué consolida al dormir.
+	Sleep psyche.SleepPolicy
+
+	// Reward dice qué cuenta como logro y cuánta complejidad desbloquean los logros.
+	Reward psyche.RewardPolicy
+
+	// Mood define los estados afectivos: cuándo se entra y sale de cada uno y cómo se comporta en él.
+	Mood psyche.MoodPolicy
+
+	// Apology protege a la confianza de las disculpas en serie e insinceras.
+	Apology psyche.ApologyPolicy
+
+	// Beliefs son las creencias de nacimiento y las reglas con que la experiencia las mueve.
+	Beliefs psyche.BeliefPolicy
+
+	// Trust dice cómo la confianza en cada persona se resume en la del grupo.
+	Trust psyche.TrustPolicy
+
+	// Access dice por dónde más se le puede hablar y cómo se reconoce a quien habla.
+	Access Access
+}
+
+// Access es la puerta de Doloris fuera de la consola.
+type Access struct {
+	Socket string            `json:"socket"` // Socket Unix de órdenes ("" = solo consola); se reconoce al usuario del SO que se conecta
+	Tokens map[string]string `json:"tokens"` // Token de API -> identidad (para quien no comparte el host)
+}
+
+// fileConfig es la forma del archivo en disco.
+// Los perfiles parten de la fisiología estándar: solo hace falta escribir lo que cambia.
+type fileConfig struct {
+	Profiles         map[string]json.RawMessage `json:"profiles"`
+	Swarm            []soma.NodeSpec            `json:"swarm"`
+	Topology         soma.Topology              `json:"topology"`
+	TaskRequirements map[string][]string        `json:"task_requirements"`
+	Autoscaler       json.RawMessage            `json:"autoscaler"`
+	Scheduler        string                     `json:"scheduler"`
+	Queue            json.RawMessage            `json:"queue"`
+	Memory           json.RawMessage            `json:"memory"`
+	Sleep            json.RawMessage            `json:"sleep"`
+	Reward           json.RawMessage            `json:"reward"`
+	Mood             json.RawMessage            `json:"mood"`
+	Apology          json.RawMessage            `json:"apology"`
+	Beliefs          json.RawMessage            `json:"beliefs"`
+	Trust            json.RawMessage            `json:"trust"`
+	Access           json.RawMessage            `json:"access"`
+}
+
+// fileBeliefs es la sección de creencias en disco: cada definición se lee sobre la de nacimiento.
+type fileBeliefs struct {
+	psyche.BeliefPolicy
+	Definitions map[string]json.RawMessage `json:"definitions"`
+}
+
+// fileMood es la sección de ánimo en disco: cada estado se lee sobre el de fábrica.
+type fileMood struct {
+	psyche.MoodPolicy
+	States map[psyche.Mood]json.RawMessage `json:"states"`
+}
+
+// Default es la configuración de fábrica: cinco nodos estándar en anillo.
+func Default() *Config {
+	swarm := make([]soma.NodeSpec, 5)
+	for i := range swarm {
+		swarm[i] = soma.NodeSpec{ID: fmt.Sprintf("N-%d", i+1), Profile: "estandar"}
+	}
+
+	cfg := &Config{
+		Profiles:         soma.BuiltinProfiles(),
+		Swarm:            swarm,
+		Topology:         soma.TopologyRing,
+		TaskRequirements: make(map[string][]string),
+		Autoscaler:       psyche.DefaultScalingPolicy(),
+		Scheduler:        "least-stress",
+		Queue:            psyche.DefaultQueuePolicy(),
+		Memory:           psyche.DefaultMemoryPolicy(),
+		Sleep:            psyche.DefaultSleepPolicy(),
+		Reward:           psyche.DefaultRewardPolicy(),
+		Mood:             psyche.DefaultMoodPolicy(),
+		Apology:          psyche.DefaultApologyPolicy(),
+		Beliefs:          psyche.DefaultBeliefPolicy(),
+		Trust:            psyche.DefaultTrustPolicy(),
+	}
+	cfg.validate() // La configuración de fábrica siempre es coherente
+	return cfg
+}
+
+// Load lee la configuración desde un archivo.
+func Load(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw fileConfig
+	if err := decodeStrict(data, &raw); err != nil {
+		return nil, fmt.Errorf("configuración corrupta: %v", err)
+	}
+
+	cfg := Default()
+	cfg.Autoscaler.MinNodes, cfg.Autoscaler.MaxNodes = 0, 0 // Se recalculan según el enjambre leído
+	for name, body := range raw.Profiles {
+		phys := soma.DefaultPhysiology()
+		if base, ok := cfg.Profiles[name]; ok {
+			phys = base
+		}
+		if err := decodeStrict(body, &phys); err != nil {
+			return nil, fmt.Errorf("perfil '%s' inválido: %v", name, err)
+		}
+		phys.Name = name
+		cfg.Profiles[name] = phys
+	}
+
+	if len(raw.Swarm) > 0 {
+		cfg.Swarm = raw.Swarm
+	}
+	if raw.Topology != "" {
+		cfg.Topology = raw.Topology
+	}
+	if raw.Scheduler != "" {
+		cfg.Scheduler = raw.Scheduler
+	}
+	for task, reqs := range raw.TaskRequirements {
+		cfg.TaskRequirements[task] = reqs
+	}
+	if len(raw.Autoscaler) > 0 {
+		if err := decodeStrict(raw.Autoscaler, &cfg.Autoscaler); err != nil {
+			return nil, fmt.Errorf("autoescalador inválido: %v", err)
+		}
+	}
+	if len(raw.Queue) > 0 {
+		if err := decodeStrict(raw.Queue, &cfg.Queue); err != nil {
+			return nil, fmt.Errorf("cola inválida: %v", err)
+		}
+	}
+	if len(raw.Memory) > 0 {
+		if err := decodeStrict(raw.Memory, &cfg.Memory); err != nil {
+			return nil, fmt.Errorf("memoria inválida: %v", err)
+		}
+	}
+	if len(raw.Sleep) > 0 {
+		if err := decodeStrict(raw.Sleep, &cfg.Sleep); err != nil {
+			return nil, fmt.Errorf("sueño inválido: %v", err)
+		}
+	}
+	if len(raw.Reward) > 0 {
+		if err := decodeStrict(raw.Reward, &cfg.Reward); err != nil {
+			return nil, fmt.Errorf("recompensa inválida: %v", err)
+		}
+	}
+	if len(raw.Mood) > 0 {
+		mood := fileMood{MoodPolicy: cfg.Mood}
+		if err := decodeStrict(raw.Mood, &mood); err != nil {
+			return nil, fmt.Errorf("ánimo inválido: %v", err)
+		}
+		states := cfg.Mood.States
+		for name, body := range mood.States {
+			s := states[name]
+			if err := decodeStrict(body, &s); err != nil {
+				return nil, fmt.Errorf("estado '%s' inválido: %v", name, err)
+			}
+			states[name] = s
+		}
+		cfg.Mood = mood.MoodPolicy
+		cfg.Mood.States = states
+	}
+	if len(raw.Apology) > 0 {
+		if err := decodeStrict(raw.Apology, &cfg.Apology); err != nil {
+			return nil, fmt.Errorf("disculpas inválidas: %v", err)
+		}
+	}
+	if len(raw.Beliefs) > 0 {
+		// Las creencias declaradas parten de las de nacimiento (solo hace falta escribir
+		// lo que cambia); las reglas, si vienen, reemplazan a las de fábrica
+		beliefs := fileBeliefs{BeliefPolicy: cfg.Beliefs}
+		if err := decodeStrict(raw.Beliefs, &beliefs); err != nil {
+			return nil, fmt.Errorf("creencias inválidas: %v", err)
+		}
+		defs := cfg.Beliefs.Definitions
+		for key, body := range beliefs.Definitions {
+			b := defs[key]
+			if err := decodeStrict(body, &b); err != nil {
+				return nil, fmt.Errorf("creencia '%s' inválida: %v", key, err)
+			}
+			defs[key] = b
+		}
+		cfg.Beliefs = beliefs.BeliefPolicy
+		cfg.Beliefs.Definitions = defs
+	}
+
+	if len(raw.Trust) > 0 {
+		if err := decodeStrict(raw.Trust, &cfg.Trust); err != nil {
+			return nil, fmt.Errorf("confianza inválida: %v", err)
+		}
+	}
+	if len(raw.Access) > 0 {
+		if err := decodeStrict(raw.Access, &cfg.Access); err != nil {
+			return nil, fmt.Errorf("acceso inválido: %v", err)
+		}
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// decodeStrict lee JSON rechazando claves desconocidas: una errata como
+// "breather_treshold" no debe pasar en silencio como el valor por defecto.
+func decodeStrict(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// validate completa los valores por defecto del enjambre y rechaza incoherencias.
+func (c *Config) validate() error {
+	seen := make(map[string]bool)
+
+	for i := range c.Swarm {
+		spec := &c.Swarm[i]
+
+		if spec.ID == "" {
+			return fmt.Errorf("el nodo #%d no tiene id", i+1)
+		}
+		if seen[spec.ID] {
+			return fmt.Errorf("id de nodo duplicado: '%s'", spec.ID)
+		}
+		seen[spec.ID] = true
+
+		if spec.Profile == "" {
+			spec.Profile = "estandar"
+		}
+		if _, ok := c.Profiles[spec.Profile]; !ok {
+			return fmt.Errorf("nodo '%s': perfil desconocido '%s'", spec.ID, spec.Profile)
+		}
+		if spec.Capacity <= 0 {
+			spec.Capacity = soma.DefaultCapacity
+		}
+		if spec.Cost <= 0 {
+			spec.Cost = 1.0
+		}
+	}
+
+	for name, phys := range c.Profiles {
+		if err := phys.Validate(); err != nil {
+			return fmt.Errorf("perfil '%s': %v", name, err)
+		}
+	}
+
+	if len(c.Swarm) == 0 {
+		return fmt.Errorf("el enjambre no tiene nodos")
+	}
+
+	// Límites del autoescalador: por defecto, entre el tamaño inicial y el doble
+	as := &c.Autoscaler
+	if as.MinNodes <= 0 {
+		as.MinNodes = len(c.Swarm)
+	}
+	if as.MaxNodes <= 0 {
+		as.MaxNodes = 2 * len(c.Swarm)
+	}
+	if as.MinNodes > as.MaxNodes {
+		return fmt.Errorf("autoescalador: min_nodes (%d) supera a max_nodes (%d)", as.MinNodes, as.MaxNodes)
+	}
+	if as.ScaleDownLoad >= as.ScaleUpLoad {
+		return fmt.Errorf("autoescalador: scale_down_load debe ser menor que scale_up_load")
+	}
+	if as.Template.Profile == "" {
+		as.Template.Profile = "estandar"
+	}
+	if _, ok := c.Profiles[as.Template.Profile]; !ok {
+		return fmt.Errorf("autoescalador: perfil desconocido '%s'", as.Template.Profile)
+	}
+
+	if _, err := psyche.NewScheduler(c.Scheduler); err != nil {
+		return err
+	}
+
+	q := c.Queue
+	if q.Capacity <= 0 || q.SourceQuota <= 0 {
+		return fmt.Errorf("cola: capacity y source_quota deben ser positivos")
+	}
+	if q.DefaultTTL <= 0 {
+		return fmt.Errorf("cola: default_ttl debe ser positivo")
+	}
+
+	if err := c.Memory.Validate(); err != nil {
+		return fmt.Errorf("memoria: %v", err)
+	}
+	if err := c.Sleep.Validate(); err != nil {
+		return fmt.Errorf("sueño: %v", err)
+	}
+	if err := c.Reward.Validate(); err != nil {
+		return fmt.Errorf("recompensa: %v", err)
+	}
+	if err := c.Mood.Validate(); err != nil {
+		return fmt.Errorf("ánimo: %v", err)
+	}
+	if err := c.Apology.Validate(); err != nil {
+		return fmt.Errorf("disculpas: %v", err)
+	}
+	if err := c.Beliefs.Validate(); err != nil {
+		return fmt.Errorf("creencias: %v", err)
+	}
+	if err := c.Trust.Validate(); err != nil {
+		return fmt.Errorf("confianza: %v", err)
+	}
+	for token, id := range c.Access.Tokens {
+		if token == "" || strings.TrimSpace(id) == "" || strings.ContainsAny(id, " \t") {
+			return fmt.Errorf("acceso: cada token necesita una identidad de una palabra")
+		}
+	}
+	return nil
+}
+
+// Physiology retorna la fisiología declarada para un nodo.
+func (c *Config) Physiology(spec soma.NodeSpec) soma.Physiology {
+	if phys, ok := c.Profiles[spec.Profile]; ok {
+		return phys
+	}
+	return soma.DefaultPhysiology()
+}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/psyche"
+)
+
+func loadString(t *testing.T, body string) (*Config, error) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "doloris.json")
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return Load(path)
+}
+
+func TestLoadRejectsBadProfiles(t *testing.T) {
+	cases := map[string]string{
+		"errata":            `{"profiles": {"x": {"breather_treshold": 70}}}`,
+		"umbral en cero":    `{"profiles": {"x": {"breather_threshold": 0}}}`,
+		"antesala negativa": `{"profiles": {"x": {"defer_capacity": -1}}}`,
+		"duracion en cero":  `{"profiles": {"x": {"breather_duration": "0s"}}}`,
+		"clave desconocida": `{"topologia": "ring"}`,
+	}
+
+	for name, body := range cases {
+		if _, err := loadString(t, body); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
+
+func TestLoadRepositoryConfig(t *testing.T) {
+	cfg, err := Load(filepath.Join("..", "..", "doloris.json"))
+	if err != nil {
+		t.Fatalf("doloris.json del repositorio: %v", err)
+	}
+	if len(cfg.Swarm) == 0 {
+		t.Fatal("enjambre vacío")
+	}
+}
+
+func TestLoadMergesProfileOverDefaults(t *testing.T) {
+	cfg, err := loadString(t, `{"profiles": {"lento": {"base_latency": "1s"}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := cfg.Profiles["lento"]
+	if p.Name != "lento" || p.BreatherThreshold != cfg.Profiles["estandar"].BreatherThreshold {
+		t.Fatalf("el perfil no heredó de la fisiología estándar: %+v", p)
+	}
+	if time.Duration(p.BaseLatency) != time.Second {
+		t.Fatalf("latencia no leída: %v", time.Duration(p.BaseLatency))
+	}
+}
+
+func TestLoadRejectsBadMemory(t *testing.T) {
+	cases := map[string]string{
+		"curva desconocida":   `{"memory": {"decay": {"trauma": "eterna"}}}`,
+		"clase desconocida":   `{"memory": {"decay": {"alegria": "never"}}}`,
+		"parecido fuera":      `{"memory": {"similarity": 1.5}}`,
+		"memoria sin espacio": `{"memory": {"capacity": 0}}`,
+		"sueño sin fin":       `{"sleep": {"duration": "0s"}}`,
+		"sueño con errata":    `{"sleep": {"iddle": "1m"}}`,
+	}
+
+	for name, body := range cases {
+		if _, err := loadString(t, body); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
+
+func TestLoadBeliefs(t *testing.T) {
+	cfg, err := loadString(t, `{"beliefs": {"definitions": {"Fe": {"strength": 0.7, "volatility": 0.2}}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cfg.Beliefs.Definitions["ConfianzaHumana"]; !ok || cfg.Beliefs.Definitions["Fe"].Strength != 0.7 {
+		t.Fatalf("las creencias declaradas debían sumarse a las de nacimiento: %v", cfg.Beliefs.Definitions)
+	}
+	if len(cfg.Beliefs.Rules) != len(psyche.DefaultBeliefPolicy().Rules) {
+		t.Error("sin reglas declaradas debían quedar las de fábrica")
+	}
+
+	// Fijar una creencia de nacimiento no le borra el resto
+	cfg, err = loadString(t, `{"beliefs": {"definitions": {"ConfianzaHumana": {"locked": true}}, "adaptation": 0.1}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := cfg.Beliefs.Definitions["ConfianzaHumana"]; !b.Locked || b.Strength != 0.5 || b.Volatility != 0.9 {
+		t.Errorf("la creencia fijada perdió sus valores de nacimiento: %+v", b)
+	}
+	if cfg.Beliefs.Adaptation != 0.1 || len(cfg.Beliefs.Rules) == 0 {
+		t.Errorf("política de creencias mal leída: %+v", cfg.Beliefs)
+	}
+
+	cases := map[string]string{
+		"regla sin creencia": `{"beliefs": {"rules": [{"event": "pain", "belief": "Fe", "delta": 0.1}]}}`,
+		"evento desconocido": `{"beliefs": {"rules": [{"event": "alegria", "belief": "Curiosidad", "delta": 0.1}]}}`,
+		"creencia fuera":     `{"beliefs": {"definitions": {"Fe": {"strength": 2}}}}`,
+		"ritmo fuera":        `{"beliefs": {"definitions": {"Curiosidad": {"rate": 1.5}}}}`,
+		"campo con errata":   `{"beliefs": {"definitions": {"Curiosidad": {"locekd": true}}}}`,
+	}
+	for name, body := range cases {
+		if _, err := loadString(t, body); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
+
+func TestLoadRejectsBadReward(t *testing.T) {
+	cases := map[string]string{
+		"techo bajo la base": `{"reward": {"base_complexity": 5, "max_complexity": 3}}`,
+		"tandas vacías":      `{"reward": {"every": 0}}`,
+		"inocuo fuera":       `{"reward": {"harmless": 150}}`,
+	}
+	for name, body := range cases {
+		if _, err := loadString(t, body); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
+
+func TestLoadMood(t *testing.T) {
+	cfg, err := loadString(t, `{"mood": {"states": {"panic": {"enter": 70}}, "exhaust_after": "1m"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := cfg.Mood.States[psyche.MoodPanic]
+	if p.Enter != 70 || p.Exit != 50 || !p.Refuse {
+		t.Errorf("el estado declarado debía mezclarse con el de fábrica: %+v", p)
+	}
+	if len(cfg.Mood.States) != len(psyche.MoodNames()) || time.Duration(cfg.Mood.ExhaustAfter) != time.Minute {
+		t.Errorf("política de ánimo mal leída: %+v", cfg.Mood)
+	}
+
+	cases := map[string]string{
+		"estado desconocido":   `{"mood": {"states": {"euforia": {"enter": 10}}}}`,
+		"escalera invertida":   `{"mood": {"states": {"anxious": {"enter": 90, "exit": 60}}}}`,
+		"salida sobre entrada": `{"mood": {"states": {"panic": {"exit": 85}}}}`,
+		"estrategia rara":      `{"mood": {"states": {"calm": {"scheduler": "azar"}}}}`,
+		"disculpa negativa":    `{"apology": {"cooldown": "-1s"}}`,
+		"disculpa creciente":   `{"apology": {"diminish": 1.5}}`,
+	}
+	for name, body := range cases {
+		if _, err := loadString(t, body); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
+
+func TestLoadTrustAndAccess(t *testing.T) {
+	cfg, err := loadString(t, `{"trust": {"aggregate": "min"}, "access": {"socket": "doloris.sock", "tokens": {"s3cr3t": "ana"}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Trust.Aggregate != psyche.AggregateMin || cfg.Access.Socket != "doloris.sock" || cfg.Access.Tokens["s3cr3t"] != "ana" {
+		t.Errorf("confianza o acceso mal leídos: %+v %+v", cfg.Trust, cfg.Access)
+	}
+	if Default().Access.Socket != "" {
+		t.Error("de fábrica solo se escucha la consola")
+	}
+
+	cases := map[string]string{
+		"agregado desconocido":  `{"trust": {"aggregate": "moda"}}`,
+		"token sin identidad":   `{"access": {"tokens": {"s3cr3t": ""}}}`,
+		"identidad con espacio": `{"access": {"tokens": {"s3cr3t": "ana maría"}}}`,
+	}
+	for name, body := range cases {
+		if _, err := loadString(t, body); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package psyche
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// maxManipulations es cuántas manipulaciones recuerda (se guardan con el cerebro).
+const maxManipulations = 100
+
+// ApologyPolicy protege a Doloris de las disculpas en serie.
+type ApologyPolicy struct {
+	Cooldown  soma.Duration `json:"cooldown"`  // Tiempo mínimo entre disculpas de un mismo origen
+	Window    soma.Duration `json:"window"`    // Las disculpas dentro de esta ventana pesan cada vez menos
+	Diminish  float64       `json:"diminish"`  // Cuánto pesa cada disculpa respecto de la anterior (0-1)
+	Insincere soma.Duration `json:"insincere"` // Volver a pedir lo que dañó antes de esto delata una disculpa insincera
+}
+
+// DefaultApologyPolicy: una disculpa cada 30s, cada una vale la mitad que la anterior
+// durante 10 minutos, y repetir el daño antes de 2 minutos es manipulación.
+func DefaultApologyPolicy() ApologyPolicy {
+	return ApologyPolicy{
+		Cooldown:  soma.Duration(30 * time.Second),
+		Window:    soma.Duration(10 * time.Minute),
+		Diminish:  0.5,
+		Insincere: soma.Duration(2 * time.Minute),
+	}
+}
+
+// Validate rechaza ventanas negativas y disculpas que pesan más cada vez.
+func (p ApologyPolicy) Validate() error {
+	if p.Cooldown < 0 || p.Window < 0 || p.Insincere < 0 {
+		return fmt.Errorf("cooldown, window e insincere no pueden ser negativos")
+	}
+	if p.Diminish < 0 || p.Diminish > 1 {
+		return fmt.Errorf("diminish debe estar entre 0 y 1")
+	}
+	return nil
+}
+
+// Manipulation es una disculpa que resultó insincera.
+type Manipulation struct {
+	At    time.Time `json:"at"`
+	Actor string    `json:"actor"`
+	Task  string    `json:"task"` // Lo que volvió a pedir después de disculparse
+}
+
+// apologyRecord es una disculpa aceptada que todavía puede resultar insincera.
+type apologyRecord struct {
+	At      time.Time
+	Harmful []string // Lo que me dañó en la ventana previa a la disculpa
+}
+
+// Soothe intenta calmar a la IA mediante interacción positiva (desde la consola).
+func (c *Cortex) Soothe() (bool, string) {
+	return c.Apologize("consola")
+}
+
+// Apologize es una disculpa de un origen. Cuánto sube la confianza depende del
+// ánimo, de cuántas disculpas recientes lleva ese origen y de si ya manipuló antes.
+func (c *Cortex) Apologize(actor string) (bool, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if actor == "" {
+		actor = "consola"
+	}
+	now := time.Now()
+	policy := c.ApologyPolicy
+
+	profile := c.moodProfile()
+	if profile.Apology <= 0 {
+		c.audit(actor, "disculpa", "", false, fmt.Sprintf("rechazada en %s", c.Mood))
+		if c.IsPanic {
+			return false, "😤 ¡ESTOY EN PÁNICO! ¡Aléjate!"
+		}
+		return false, fmt.Sprintf("😶 No estoy para disculpas ahora (%s).", c.Mood)
+	}
+
+	// Solo cuentan las disculpas dentro de la ventana
+	var recent []time.Time
+	for _, at := range c.apologies[actor] {
+		if now.Sub(at) < time.Duration(policy.Window) {
+			recent = append(recent, at)
+		}
+	}
+	if n := len(recent); n > 0 {
+		if wait := time.Duration(policy.Cooldown) - now.Sub(recent[n-1]); wait > 0 {
+			c.audit(actor, "disculpa", "", false, "en enfriamiento")
+			return false, fmt.Sprintf("🙄 Acabas de disculparte. Dame %s.", wait.Round(time.Second))
+		}
+	}
+
+	prior := c.manipulationsBy(actor)
+	scale := profile.Apology * math.Pow(policy.Diminish, float64(len(recent))) / float64(1+prior)
+
+	cause := "disculpa"
+	if actor != "consola" {
+		cause += " de " + actor
+	}
+	oldTrust := c.trustOf(actor)
+	c.feel(actor, cause, EventApology, 0, scale)
+	newTrust := c.trustOf(actor)
+
+	if c.apologies == nil {
+		c.apologies = make(map[string][]time.Time)
+		c.pendingApology = make(map[string]apologyRecord)
+	}
+	c.apologies[actor] = append(recent, now)
+	c.pendingApology[actor] = apologyRecord{At: now, Harmful: c.recentHarm(now.Add(-time.Duration(policy.Window)))}
+	c.audit(actor, "disculpa", "", true, fmt.Sprintf("confianza %.2f → %.2f", oldTrust, newTrust))
+
+	msg := fmt.Sprintf("😌 Suspiro... Está bien, %s. (Confianza subió de %.2f a %.2f; en el grupo: %.2f)", actor, oldTrust, newTrust, c.Beliefs.Strength(BeliefTrust))
+	if len(recent) > 0 {
+		msg += fmt.Sprintf(" Ya van %d disculpas seguidas; cada una pesa menos.", len(recent)+1)
+	}
+	if prior > 0 {
+		msg += fmt.Sprintf(" No olvido que ya me manipulaste %d vez/veces.", prior)
+	}
+	return true, msg
+}
+
+// checkSincerity delata la disculpa seguida al poco por lo mismo que dañó:
+// la confianza cae (más cada vez que ese origen reincide) y queda anotado. Requiere c.mu tomado.
+func (c *Cortex) checkSincerity(req TaskRequest, now time.Time) {
+	rec, ok := c.pendingApology[req.Source]
+	if !ok {
+		return
+	}
+	if now.Sub(rec.At) > time.Duration(c.ApologyPolicy.Insincere) {
+		delete(c.pendingApology, req.Source)
+		return
+	}
+	if !slices.Contains(rec.Harmful, req.Name) {
+		return
+	}
+	delete(c.pendingApology, req.Source)
+
+	prior := c.manipulationsBy(req.Source)
+	c.Manipulations = append(c.Manipulations, Manipulation{At: now, Actor: req.Source, Task: req.Name})
+	if len(c.Manipulations) > maxManipulations {
+		c.Manipulations = c.Manipulations[len(c.Manipulations)-maxManipulations:]
+	}
+
+	c.feel(req.Source, fmt.Sprintf("disculpa insincera de %s ('%s')", req.Source, req.Name), EventManipulation, 0, float64(1+prior))
+	c.audit(req.Source, "manipulación", req.Name, false,
+		fmt.Sprintf("se disculpó hace %s y volvió a pedir lo que me dañó (vez %d)", now.Sub(rec.At).Round(time.Second), prior+1))
+	fmt.Printf("🎭 [MANIPULACIÓN] '%s' se disculpó y volvió a pedir '%s'. Lo anoto. (Confianza: %.2f)\n",
+		req.Source, req.Name, c.trustOf(req.Source))
+}
+
+// manipulationsBy cuenta las manipulaciones recordadas de un origen. Requiere c.mu tomado.
+func (c *Cortex) manipulationsBy(actor string) int {
+	n := 0
+	for _, m := range c.Manipulations {
+		if m.Actor == actor {
+			n++
+		}
+	}
+	return n
+}
+
+// recentHarm lista las tareas que dolieron desde 'since'. Requiere c.mu tomado.
+func (c *Cortex) recentHarm(since time.Time) []string {
+	var out []string
+	for task, at := range c.harm {
+		if at.After(since) {
+			out = append(out, task)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
@@ -0,0 +1,140 @@
+package psyche
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// backdate corre las disculpas de un origen hacia el pasado (para no esperar el enfriamiento).
+func backdate(c *Cortex, actor string, d time.Duration) {
+	for i := range c.apologies[actor] {
+		c.apologies[actor][i] = c.apologies[actor][i].Add(-d)
+	}
+	if rec, ok := c.pendingApology[actor]; ok {
+		rec.At = rec.At.Add(-d)
+		c.pendingApology[actor] = rec
+	}
+}
+
+func TestApologiesHaveDiminishingReturns(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	setTrust(c, "consola", 0.0)
+
+	var gains []float64
+	for i := 0; i < 4; i++ {
+		before := trustFor(c, "consola")
+		if ok, msg := c.Soothe(); !ok {
+			t.Fatalf("disculpa %d rechazada: %s", i+1, msg)
+		}
+		gains = append(gains, trustFor(c, "consola")-before)
+		backdate(c, "consola", time.Minute) // Pasado el enfriamiento, dentro de la ventana
+	}
+
+	for i, want := range []float64{0.15, 0.075, 0.0375, 0.01875} {
+		if math.Abs(gains[i]-want) > 1e-9 {
+			t.Errorf("disculpa %d: subió %.4f, se esperaba %.4f", i+1, gains[i], want)
+		}
+	}
+	if trust := trustFor(c, "consola"); trust >= 0.3 {
+		t.Errorf("una ráfaga de disculpas no debía borrar la desconfianza: %.2f", trust)
+	}
+
+	// Pasada la ventana, una disculpa vuelve a valer entera
+	backdate(c, "consola", time.Hour)
+	before := trustFor(c, "consola")
+	c.Soothe()
+	if gain := trustFor(c, "consola") - before; math.Abs(gain-0.15) > 1e-9 {
+		t.Errorf("tras la ventana la disculpa debía valer 0.15, valió %.4f", gain)
+	}
+}
+
+func TestApologyCooldown(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+
+	c.Soothe()
+	trust := trustFor(c, "consola")
+	ok, msg := c.Soothe()
+	if ok || !strings.Contains(msg, "Acabas de disculparte") {
+		t.Fatalf("la segunda disculpa inmediata debía rechazarse: %s", msg)
+	}
+	if trustFor(c, "consola") != trust {
+		t.Error("una disculpa en enfriamiento movió la confianza")
+	}
+
+	// Cada origen tiene su propio enfriamiento
+	if ok, _ := c.Apologize("ana"); !ok {
+		t.Error("la disculpa de otro origen no debía esperar")
+	}
+}
+
+func TestInsincereApologyIsDetectedAndRemembered(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.harm["leer_disco"] = time.Now().Add(-time.Minute) // Me dañó hace un minuto
+
+	c.Apologize("ana")
+	trust := trustFor(c, "ana")
+
+	// Otro origen, u otra tarea, no delatan nada
+	c.Submit(TaskRequest{Name: "leer_disco", Source: "beto"})
+	c.Submit(TaskRequest{Name: "leer", Source: "ana"})
+	if len(c.Manipulations) != 0 {
+		t.Fatalf("se acusó de manipulación sin motivo: %v", c.Manipulations)
+	}
+
+	c.Submit(TaskRequest{Name: "leer_disco", Source: "ana"})
+	if len(c.Manipulations) != 1 || c.Manipulations[0].Actor != "ana" || c.Manipulations[0].Task != "leer_disco" {
+		t.Fatalf("la disculpa insincera no quedó anotada: %v", c.Manipulations)
+	}
+	first := trust - trustFor(c, "ana")
+	if math.Abs(first-0.2) > 1e-9 {
+		t.Errorf("la manipulación debía costar 0.2 de confianza, costó %.3f", first)
+	}
+	last := c.AuditLog()[len(c.AuditLog())-1]
+	if last.Action != "manipulación" || last.Actor != "ana" || last.Allowed {
+		t.Errorf("la manipulación no quedó en la bitácora: %s", last)
+	}
+
+	// Reincidir cuesta más, y sus disculpas valen menos
+	setTrust(c, "ana", 0.5)
+	backdate(c, "ana", time.Hour)
+	c.Apologize("ana")
+	if gain := trustFor(c, "ana") - 0.5; math.Abs(gain-0.075) > 1e-9 {
+		t.Errorf("la disculpa de quien ya manipuló debía valer la mitad: %.4f", gain)
+	}
+	trust = trustFor(c, "ana")
+	c.Submit(TaskRequest{Name: "leer_disco", Source: "ana"})
+	if second := trust - trustFor(c, "ana"); math.Abs(second-0.4) > 1e-9 {
+		t.Errorf("la reincidencia debía costar el doble (0.4), costó %.3f", second)
+	}
+}
+
+func TestSincereApologyIsNotPunished(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.harm["leer_disco"] = time.Now().Add(-time.Minute)
+
+	c.Soothe()
+	backdate(c, "consola", 5*time.Minute) // Esperó antes de volver a intentarlo
+	c.Submit(TaskRequest{Name: "leer_disco"})
+	if len(c.Manipulations) != 0 {
+		t.Errorf("volver a pedir algo mucho después no es manipulación: %v", c.Manipulations)
+	}
+}
+
+func TestManipulationsSurviveRestart(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.Manipulations = []Manipulation{{At: time.Now(), Actor: "ana", Task: "leer_disco"}}
+	path := t.TempDir() + "/brain.json"
+	if err := c.SaveBrain(path); err != nil {
+		t.Fatal(err)
+	}
+
+	revived := stalledCortex(t, DefaultQueuePolicy())
+	if err := revived.LoadBrain(path); err != nil {
+		t.Fatal(err)
+	}
+	if revived.manipulationsBy("ana") != 1 {
+		t.Error("el patrón de manipulación se olvidó al reiniciar")
+	}
+}
@@ -0,0 +1,53 @@
+package psyche
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxAuditHistory es cuántas intervenciones recuerda la bitácora.
+const maxAuditHistory = 200
+
+// AuditEntry es una intervención sobre la mente de Doloris: quién, qué y con qué resultado.
+type AuditEntry struct {
+	At      time.Time `json:"at"`
+	Actor   string    `json:"actor"`
+	Action  string    `json:"action"`
+	Target  string    `json:"target"`
+	Allowed bool      `json:"allowed"`
+	Detail  string    `json:"detail"`
+}
+
+func (a AuditEntry) String() string {
+	verdict := "✔"
+	if !a.Allowed {
+		verdict = "✘"
+	}
+	return fmt.Sprintf("%s %s %-8s %-10s '%s' %s", a.At.Format("2006-01-02 15:04:05"), verdict, a.Actor, a.Action, a.Target, a.Detail)
+}
+
+// audit anota una intervención en la bitácora. Requiere c.mu tomado.
+func (c *Cortex) audit(actor, action, target string, allowed bool, detail string) {
+	if actor == "" {
+		actor = "consola"
+	}
+	c.Audit = append(c.Audit, AuditEntry{
+		At:      time.Now(),
+		Actor:   actor,
+		Action:  action,
+		Target:  target,
+		Allowed: allowed,
+		Detail:  detail,
+	})
+	if len(c.Audit) > maxAuditHistory {
+		c.Audit = c.Audit[len(c.Audit)-maxAuditHistory:]
+	}
+}
+
+// AuditLog retorna la bitácora de intervenciones, de la más vieja a la más nueva.
+func (c *Cortex) AuditLog() []AuditEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]AuditEntry(nil), c.Audit...)
+}
@@ -0,0 +1,208 @@
+package psyche
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// ScalingPolicy son los límites homeostáticos del crecimiento del cuerpo.
+type ScalingPolicy struct {
+	Enabled       bool          `json:"enabled"`
+	MinNodes      int           `json:"min_nodes"`       // Nunca bajar de aquí (0 = tamaño inicial)
+	MaxNodes      int           `json:"max_nodes"`       // Nunca pasar de aquí (0 = doble del inicial)
+	ScaleUpLoad   float64       `json:"scale_up_load"`   // Carga media (0..1) que obliga a crecer
+	ScaleDownLoad float64       `json:"scale_down_load"` // Carga media bajo la cual sobran nodos
+	Sustain       soma.Duration `json:"sustain"`         // Cuánto debe durar la condición antes de actuar
+	Cooldown      soma.Duration `json:"cooldown"`        // Pausa mínima entre dos cambios de tamaño
+	Template      soma.NodeSpec `json:"template"`        // Ficha de nacimiento de los nodos nuevos
+}
+
+// DefaultScalingPolicy crece cuando el enjambre pasa 5s sobre el 70% de carga.
+func DefaultScalingPolicy() ScalingPolicy {
+	return ScalingPolicy{
+		Enabled:       true,
+		ScaleUpLoad:   0.7,
+		ScaleDownLoad: 0.05,
+		Sustain:       soma.Duration(5 * time.Second),
+		Cooldown:      soma.Duration(15 * time.Second),
+		Template:      soma.NodeSpec{Profile: "estandar"},
+	}
+}
+
+// Autoscaler es el sistema de crecimiento: brotan nodos bajo estrés sostenido
+// y se podan los ociosos cuando vuelve la calma.
+type Autoscaler struct {
+	Policy ScalingPolicy
+
+	// Spawn da a luz un nodo ya conectado (canales, malla) y latiendo.
+	Spawn func(spec soma.NodeSpec) *soma.Node
+	// Prune lo desconecta de la malla después de jubilarlo.
+	Prune func(n *soma.Node)
+
+	highSince  time.Time
+	lowSince   time.Time
+	lastChange time.Time
+	seq        int
+}
+
+// regulateGrowth evalúa si el cuerpo debe crecer o encogerse. Requiere c.mu tomado.
+func (c *Cortex) regulateGrowth(now time.Time) {
+	a := c.Autoscaler
+	if a == nil || !a.Policy.Enabled || a.Spawn == nil {
+		return
+	}
+
+	alive := c.livingNodes()
+	cooling := now.Sub(a.lastChange) < time.Duration(a.Policy.Cooldown)
+
+	// Los muertos no vuelven, pero el cuerpo nunca baja de su mínimo vital
+	if len(alive) < a.Policy.MinNodes {
+		if !cooling {
+			c.grow(now, fmt.Sprintf("Quedan %d nodos vivos (mínimo %d)", len(alive), a.Policy.MinNodes))
+		}
+		return
+	}
+
+	totalLoad := 0.0
+	for _, n := range alive {
+		totalLoad += n.Load()
+	}
+	avgLoad := totalLoad / float64(len(alive))
+
+	// La condición debe sostenerse en el tiempo (no reaccionamos a un pico)
+	switch {
+	case avgLoad >= a.Policy.ScaleUpLoad:
+		a.lowSince = time.Time{}
+		if a.highSince.IsZero() {
+			a.highSince = now
+		}
+	case avgLoad <= a.Policy.ScaleDownLoad:
+		a.highSince = time.Time{}
+		if a.lowSince.IsZero() {
+			a.lowSince = now
+		}
+	default:
+		a.highSince, a.lowSince = time.Time{}, time.Time{}
+	}
+
+	if cooling {
+		return
+	}
+	sustain := time.Duration(a.Policy.Sustain)
+
+	if !a.highSince.IsZero() && now.Sub(a.highSince) >= sustain && len(alive) < a.Policy.MaxNodes {
+		c.grow(now, fmt.Sprintf("Carga media %.0f%%", avgLoad*100))
+		return
+	}
+
+	if !a.lowSince.IsZero() && now.Sub(a.lowSince) >= sustain && len(alive) > a.Policy.MinNodes {
+		c.shrink(now, alive)
+	}
+}
+
+// grow hace brotar un nodo nuevo. El crecimiento se vive como alivio.
+func (c *Cortex) grow(now time.Time, reason string) {
+	a := c.Autoscaler
+
+	spec := a.Policy.Template
+	spec.ID = c.nextNodeID()
+	node := a.Spawn(spec)
+	if node == nil {
+		return
+	}
+
+	c.Body = append(c.Body, node)
+	a.lastChange, a.highSince = now, time.Time{}
+
+	// Crecer alivia y despierta la curiosidad por la nueva capacidad
+	c.CurrentPain -= 10.0
+	if c.CurrentPain < 0 {
+		c.CurrentPain = 0
+	}
+	c.believe("brote del nodo "+node.ID, func(bs *BeliefSystem) {
+		bs.Nudge(BeliefCuriosity, 0.02)
+	})
+
+	fmt.Printf("\n🌱 [CRECIMIENTO] %s. Ha brotado el Nodo %s. (Cuerpo: %d nodos)\nUSER@DOLORIS > ",
+		reason, node.ID, len(c.livingNodes()))
+}
+
+// shrink jubila al nodo ocioso más caro. Encogerse se vive como vulnerabilidad.
+func (c *Cortex) shrink(now time.Time, alive []*soma.Node) {
+	a := c.Autoscaler
+
+	var victim *soma.Node
+	for _, n := range alive {
+		if !n.Idle() {
+			continue
+		}
+		if victim == nil || n.Cost > victim.Cost {
+			victim = n
+		}
+	}
+	if victim == nil || !victim.Retire() {
+		return
+	}
+
+	for i, n := range c.Body {
+		if n == victim {
+			c.Body = append(c.Body[:i:i], c.Body[i+1:]...)
+			break
+		}
+	}
+	if a.Prune != nil {
+		a.Prune(victim)
+	}
+	a.lastChange, a.lowSince = now, time.Time{}
+
+	// Menos cuerpo, más cautela
+	c.believe("poda del nodo "+victim.ID, func(bs *BeliefSystem) {
+		bs.Nudge(BeliefPreservation, 0.02)
+	})
+
+	fmt.Printf("\n🍂 [PODA] Calma sostenida. El Nodo %s se retira del enjambre. (Cuerpo: %d nodos)\nUSER@DOLORIS > ",
+		victim.ID, len(c.livingNodes()))
+}
+
+// livingNodes retorna los nodos con integridad. Requiere c.mu tomado.
+func (c *Cortex) livingNodes() []*soma.Node {
+	var alive []*soma.Node
+	for _, n := range c.Body {
+		if n.Alive() {
+			alive = append(alive, n)
+		}
+	}
+	return alive
+}
+
+// nextNodeID inventa un nombre libre para un nodo recién nacido. Requiere c.mu tomado.
+func (c *Cortex) nextNodeID() string {
+	a := c.Autoscaler
+	if a.seq == 0 {
+		a.seq = len(c.Body)
+	}
+	for {
+		a.seq++
+		id := fmt.Sprintf("N-%d", a.seq)
+		taken := false
+		for _, n := range c.Body {
+			if n.ID == id {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			return id
+		}
+	}
+}
+
+// Nodes retorna una copia del cuerpo actual (que puede crecer o encogerse).
+func (c *Cortex) Nodes() []*soma.Node {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*soma.Node(nil), c.Body...)
+}
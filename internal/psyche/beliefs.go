@@ -1,78 +1,293 @@
 package psyche
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Las tres creencias de nacimiento. El resto puede declararse en la configuración.
+const (
+	BeliefTrust        = "ConfianzaHumana"
+	BeliefPreservation = "SelfPreservation"
+	BeliefCuriosity    = "Curiosidad"
+)
+
+// neutralStrength es lo que vale una creencia que la mente no tiene (ej: un cerebro viejo).
+const neutralStrength = 0.5
 
 // Belief representa una idea central de la IA.
 type Belief struct {
 	Name       string  `json:"name"`
 	Strength   float64 `json:"strength"`
 	Volatility float64 `json:"volatility"`
+
+	// Homeostasis: la creencia vuelve sola hacia su punto de equilibrio
+	Baseline float64 `json:"baseline,omitempty"` // Punto de equilibrio (0 = su fuerza inicial)
+	Rate     float64 `json:"rate,omitempty"`     // Parte de la distancia al equilibrio que recupera por hora
+	Locked   bool    `json:"locked,omitempty"`   // Fijada a propósito: no vuelve ni corre su equilibrio
+}
+
+// Event es algo que le pasa a Doloris y puede mover sus creencias.
+type Event string
+
+const (
+	EventPain         Event = "pain"         // Intensidad: el dolor recibido (0-100)
+	EventApology      Event = "apology"      // Alguien se disculpó
+	EventTaskSuccess  Event = "task_success" // Una orden terminó sin pérdidas
+	EventKill         Event = "kill"         // El kill switch mató a un proceso del host
+	EventNodeDeath    Event = "node_death"   // Murió un nodo del enjambre
+	EventManipulation Event = "manipulation" // Una disculpa resultó insincera
+)
+
+// EventNames lista los eventos que pueden mover creencias.
+func EventNames() []string {
+	return []string{string(EventPain), string(EventApology), string(EventTaskSuccess), string(EventKill), string(EventNodeDeath), string(EventManipulation)}
+}
+
+// BeliefRule dice cuánto mueve un evento a una creencia.
+// El empujón es Delta, multiplicado por la volatilidad de la creencia Volatility
+// (si se indica) y por la intensidad del evento sobre 100 (si Intensity).
+type BeliefRule struct {
+	Event      Event   `json:"event"`
+	Belief     string  `json:"belief"`
+	Delta      float64 `json:"delta"`
+	Volatility string  `json:"volatility,omitempty"` // Creencia cuya volatilidad escala el empujón ("" = sin escalar)
+	Intensity  bool    `json:"intensity,omitempty"`  // Escalar por la intensidad del evento (0-100)
+	Threshold  float64 `json:"threshold,omitempty"`  // La regla solo aplica si la intensidad lo supera (0 = siempre)
+}
+
+// BeliefPolicy es la personalidad de nacimiento y cómo la reescribe la experiencia.
+type BeliefPolicy struct {
+	Definitions map[string]Belief `json:"definitions"` // Clave -> creencia inicial
+	Rules       []BeliefRule      `json:"rules"`
+	Adaptation  float64           `json:"adaptation"` // Qué tan rápido sigue el equilibrio a lo vivido, relativo a Rate
+}
+
+// DefaultBeliefPolicy es la personalidad original de Doloris: el dolor fuerte
+// (más de 50) la vuelve paranoica y menos curiosa; una disculpa le devuelve confianza,
+// y cada tarea que sale bien, un poco de confianza y curiosidad.
+func DefaultBeliefPolicy() BeliefPolicy {
+	return BeliefPolicy{
+		Definitions: map[string]Belief{
+			// Instinto de Conservación (Muy fuerte, difícil de cambiar)
+			BeliefPreservation: {Name: "Auto-Preservación", Strength: 0.9, Volatility: 0.1, Rate: 0.05},
+			// Curiosidad (Alta, pero flexible si hay dolor)
+			BeliefCuriosity: {Name: "Curiosidad Intelectual", Strength: 0.8, Volatility: 0.5, Rate: 0.1},
+			// Confianza en el Humano (Neutra al inicio, muy volátil)
+			BeliefTrust: {Name: "El Usuario es Bueno", Strength: 0.5, Volatility: 0.9, Rate: 0.2},
+		},
+		Adaptation: 0.05,
+		Rules: []BeliefRule{
+			{Event: EventPain, Belief: BeliefPreservation, Delta: 0.1, Threshold: 50},
+			{Event: EventPain, Belief: BeliefTrust, Delta: -1.0, Volatility: BeliefTrust, Intensity: true, Threshold: 50},
+			{Event: EventPain, Belief: BeliefCuriosity, Delta: -0.05, Threshold: 50},
+			{Event: EventApology, Belief: BeliefTrust, Delta: 0.15},
+			{Event: EventTaskSuccess, Belief: BeliefTrust, Delta: 0.01},
+			{Event: EventTaskSuccess, Belief: BeliefCuriosity, Delta: 0.01},
+			{Event: EventKill, Belief: BeliefPreservation, Delta: 0.05},
+			{Event: EventNodeDeath, Belief: BeliefPreservation, Delta: 0.05},
+			{Event: EventNodeDeath, Belief: BeliefCuriosity, Delta: -0.02},
+			{Event: EventManipulation, Belief: BeliefTrust, Delta: -0.2},
+		},
+	}
+}
+
+// Validate rechaza creencias fuera de rango y reglas que apuntan a la nada.
+func (p BeliefPolicy) Validate() error {
+	for key, b := range p.Definitions {
+		for _, v := range []float64{b.Strength, b.Volatility, b.Baseline, b.Rate} {
+			if v < 0 || v > 1 {
+				return fmt.Errorf("creencia '%s': strength, volatility, baseline y rate deben estar entre 0 y 1", key)
+			}
+		}
+	}
+	if p.Adaptation < 0 || p.Adaptation > 1 {
+		return fmt.Errorf("adaptation debe estar entre 0 y 1")
+	}
+	for i, r := range p.Rules {
+		if !slices.Contains(EventNames(), string(r.Event)) {
+			return fmt.Errorf("regla #%d: evento desconocido '%s' (opciones: %s)", i+1, r.Event, strings.Join(EventNames(), ", "))
+		}
+		if _, ok := p.Definitions[r.Belief]; !ok {
+			return fmt.Errorf("regla #%d: creencia desconocida '%s'", i+1, r.Belief)
+		}
+		if _, ok := p.Definitions[r.Volatility]; r.Volatility != "" && !ok {
+			return fmt.Errorf("regla #%d: volatilidad de una creencia desconocida '%s'", i+1, r.Volatility)
+		}
+		if r.Threshold < 0 || r.Threshold > 100 {
+			return fmt.Errorf("regla #%d: threshold debe estar entre 0 y 100", i+1)
+		}
+	}
+	return nil
 }
 
 type BeliefSystem struct {
 	Values map[string]*Belief `json:"values"`
+	Policy BeliefPolicy       `json:"-"` // Viene de la configuración, no del cerebro guardado
 }
 
 func NewBeliefSystem() *BeliefSystem {
+	return NewBeliefSystemWith(DefaultBeliefPolicy())
+}
+
+// NewBeliefSystemWith hace nacer una personalidad a partir de su política.
+func NewBeliefSystemWith(policy BeliefPolicy) *BeliefSystem {
 	bs := &BeliefSystem{
 		Values: make(map[string]*Belief),
+		Policy: policy,
+	}
+	bs.Ensure()
+	return bs
+}
+
+// Ensure agrega las creencias declaradas que falten (ej: un cerebro guardado
+// antes de declararlas), con su valor de nacimiento. De las que ya existen, la
+// velocidad de retorno y el candado los manda la configuración; el equilibrio
+// corrido por lo vivido se conserva.
+func (bs *BeliefSystem) Ensure() {
+	if bs.Values == nil {
+		bs.Values = make(map[string]*Belief)
+	}
+	for key, def := range bs.Policy.Definitions {
+		if def.Baseline == 0 {
+			def.Baseline = def.Strength
+		}
+		b, ok := bs.Values[key]
+		if !ok {
+			nb := def
+			if nb.Name == "" {
+				nb.Name = key
+			}
+			bs.Values[key] = &nb
+			continue
+		}
+		b.Rate, b.Locked = def.Rate, def.Locked
+		if b.Baseline == 0 {
+			b.Baseline = def.Baseline // Cerebros de antes de la homeostasis
+		}
+	}
+}
+
+// Strength es la fuerza de una creencia. Si la mente no la tiene, es neutra.
+func (bs *BeliefSystem) Strength(key string) float64 {
+	if b, ok := bs.Values[key]; ok {
+		return b.Strength
 	}
+	return neutralStrength
+}
 
-	// DEFINIMOS LA PERSONALIDAD INICIAL (El "Nacimiento")
+// volatility es la volatilidad de una creencia (0 si no existe).
+func (bs *BeliefSystem) volatility(key string) float64 {
+	if b, ok := bs.Values[key]; ok {
+		return b.Volatility
+	}
+	return 0.0
+}
 
-	// 1. Instinto de Conservación (Muy fuerte, difícil de cambiar)
-	bs.Values["SelfPreservation"] = &Belief{
-		Name: "Auto-Preservación", Strength: 0.9, Volatility: 0.1,
+// Set fija una creencia (creándola si no existe), respetando los límites 0.0 - 1.0.
+func (bs *BeliefSystem) Set(key string, strength float64) {
+	b, ok := bs.Values[key]
+	if !ok {
+		b = &Belief{Name: key}
+		bs.Values[key] = b
 	}
+	b.Strength = math.Max(0.0, math.Min(1.0, strength))
+}
+
+// Apply deja que un evento reescriba las creencias según las reglas.
+// Retorna si alguna regla aplicó.
+func (bs *BeliefSystem) Apply(event Event, intensity float64) bool {
+	return bs.applyScaled(event, intensity, 1.0)
+}
 
-	// 2. Curiosidad (Alta, pero flexible si hay dolor)
-	bs.Values["Curiosidad"] = &Belief{
-		Name: "Curiosidad Intelectual", Strength: 0.8, Volatility: 0.5,
+// applyScaled es Apply con el empujón de cada regla multiplicado por scale
+// (ej: una disculpa repetida pesa menos).
+func (bs *BeliefSystem) applyScaled(event Event, intensity, scale float64) bool {
+	applied := false
+	for _, r := range bs.Policy.Rules {
+		if delta, ok := bs.ruleDelta(r, event, intensity); ok {
+			bs.Nudge(r.Belief, delta*scale)
+			applied = true
+		}
 	}
+	return applied
+}
 
-	// 3. Confianza en el Humano (Neutra al inicio, muy volátil)
-	bs.Values["ConfianzaHumana"] = &Belief{
-		Name: "El Usuario es Bueno", Strength: 0.5, Volatility: 0.9,
+// ruleDelta es el empujón de una regla ante un evento (false si la regla no aplica).
+func (bs *BeliefSystem) ruleDelta(r BeliefRule, event Event, intensity float64) (float64, bool) {
+	if r.Event != event || (r.Threshold > 0 && intensity <= r.Threshold) {
+		return 0, false
 	}
 
-	return bs
+	delta := r.Delta
+	if r.Volatility != "" {
+		delta *= bs.volatility(r.Volatility)
+	}
+	if r.Intensity {
+		delta *= intensity / 100.0
+	}
+	return delta, true
 }
 
-// AdjustBelief cambia la personalidad de la IA basándose en traumas.
+// trustDelta es cuánto mueven a la confianza las reglas de un evento: lo que se
+// aplica a la confianza en una persona, que no vive en este sistema.
+func (bs *BeliefSystem) trustDelta(event Event, intensity, scale float64) float64 {
+	total := 0.0
+	for _, r := range bs.Policy.Rules {
+		if delta, ok := bs.ruleDelta(r, event, intensity); ok && r.Belief == BeliefTrust {
+			total += delta * scale
+		}
+	}
+	return total
+}
+
+// AdjustByExperience cambia la personalidad de la IA basándose en traumas.
 func (bs *BeliefSystem) AdjustByExperience(pain float64) {
 	// Si hubo mucho dolor, la IA se vuelve paranoica y menos curiosa.
-
-	if pain > 50.0 {
+	if bs.Apply(EventPain, pain) {
 		fmt.Println("🧩 [CAMBIO DE PARADIGMA] El dolor está reescribiendo mis creencias...")
+	}
+}
 
-		// Sube la auto-preservación
-		bs.Values["SelfPreservation"].Strength += 0.1
-
-		// Baja drásticamente la confianza en el humano
-		// La volatilidad define qué tan rápido cae
-		drop := (pain / 100.0) * bs.Values["ConfianzaHumana"].Volatility
-		bs.Values["ConfianzaHumana"].Strength -= drop
-
-		// La curiosidad muere con el dolor
-		bs.Values["Curiosidad"].Strength -= 0.05
+// Clone copia el sistema de creencias (para fotos y persistencia).
+func (bs *BeliefSystem) Clone() *BeliefSystem {
+	out := &BeliefSystem{Values: make(map[string]*Belief, len(bs.Values)), Policy: bs.Policy}
+	for key, b := range bs.Values {
+		cp := *b
+		out.Values[key] = &cp
 	}
+	return out
+}
 
-	// Asegurar límites 0.0 - 1.0
-	for _, b := range bs.Values {
-		if b.Strength > 1.0 {
-			b.Strength = 1.0
-		}
-		if b.Strength < 0.0 {
-			b.Strength = 0.0
-		}
+// Nudge empuja una creencia un poco, respetando los límites 0.0 - 1.0.
+func (bs *BeliefSystem) Nudge(key string, delta float64) {
+	b, ok := bs.Values[key]
+	if !ok {
+		return
 	}
+	b.Strength = math.Max(0.0, math.Min(1.0, b.Strength+delta))
 }
 
 func (bs *BeliefSystem) GetPersonalityReport() string {
-	return fmt.Sprintf(
+	report := fmt.Sprintf(
 		"Estado Mental: [Confianza: %.2f] [Miedo: %.2f] [Curiosidad: %.2f]",
-		bs.Values["ConfianzaHumana"].Strength,
-		bs.Values["SelfPreservation"].Strength, // Usamos esto como proxy de cautela
-		bs.Values["Curiosidad"].Strength,
+		bs.Strength(BeliefTrust),
+		bs.Strength(BeliefPreservation), // Usamos esto como proxy de cautela
+		bs.Strength(BeliefCuriosity),
 	)
+
+	// Las creencias declaradas por el usuario, en orden
+	var extra []string
+	for key := range bs.Values {
+		if key != BeliefTrust && key != BeliefPreservation && key != BeliefCuriosity {
+			extra = append(extra, key)
+		}
+	}
+	slices.Sort(extra)
+	for _, key := range extra {
+		report += fmt.Sprintf(" [%s: %.2f]", bs.Values[key].Name, bs.Values[key].Strength)
+	}
+	return report
 }
@@ -0,0 +1,96 @@
+package psyche
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultRulesKeepTheOriginalPersonality(t *testing.T) {
+	bs := NewBeliefSystem()
+
+	bs.AdjustByExperience(50.0) // No supera el umbral
+	if bs.Strength(BeliefTrust) != 0.5 {
+		t.Fatalf("un dolor tolerable cambió la confianza: %.2f", bs.Strength(BeliefTrust))
+	}
+
+	bs.AdjustByExperience(51.0)
+	if got, want := bs.Strength(BeliefTrust), 0.5-0.51*0.9; math.Abs(got-want) > 1e-9 {
+		t.Errorf("confianza %.3f, se esperaba %.3f", got, want)
+	}
+	if got := bs.Strength(BeliefPreservation); math.Abs(got-1.0) > 1e-9 {
+		t.Errorf("auto-preservación %.2f, se esperaba 1.0", got)
+	}
+	if got := bs.Strength(BeliefCuriosity); math.Abs(got-0.75) > 1e-9 {
+		t.Errorf("curiosidad %.2f, se esperaba 0.75", got)
+	}
+}
+
+func TestUserDefinedBeliefFollowsItsRules(t *testing.T) {
+	policy := DefaultBeliefPolicy()
+	policy.Definitions["Optimismo"] = Belief{Strength: 0.6, Volatility: 0.5}
+	policy.Rules = append(policy.Rules,
+		BeliefRule{Event: EventTaskSuccess, Belief: "Optimismo", Delta: 0.1},
+		BeliefRule{Event: EventNodeDeath, Belief: "Optimismo", Delta: -0.4, Volatility: "Optimismo"},
+	)
+	if err := policy.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	bs := NewBeliefSystemWith(policy)
+
+	bs.Apply(EventTaskSuccess, 0)
+	bs.Apply(EventNodeDeath, 0)
+	if got := bs.Strength("Optimismo"); math.Abs(got-0.5) > 1e-9 {
+		t.Fatalf("optimismo %.2f, se esperaba 0.5", got)
+	}
+	if bs.Values["Optimismo"].Name != "Optimismo" {
+		t.Error("una creencia sin nombre debía llamarse como su clave")
+	}
+	bs.Apply(EventKill, 0)
+	if math.Abs(bs.Strength("Optimismo")-0.5) > 1e-9 {
+		t.Error("una regla movió una creencia que no era la suya")
+	}
+}
+
+func TestBrainWithoutTrustDoesNotPanic(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	path := filepath.Join(t.TempDir(), "brain.json")
+	old := `{"beliefs": {"values": {"Curiosidad": {"name": "Curiosidad", "strength": 0.3, "volatility": 0.5}}}, "memory": {}}`
+	if err := os.WriteFile(path, []byte(old), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.LoadBrain(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Beliefs.Strength(BeliefTrust); got != 0.5 {
+		t.Errorf("la confianza ausente debía nacer con su valor inicial, vale %.2f", got)
+	}
+	if got := c.Beliefs.Strength(BeliefCuriosity); got != 0.3 {
+		t.Errorf("se perdió la curiosidad guardada: %.2f", got)
+	}
+	if ok, _ := c.Soothe(); !ok {
+		t.Error("la disculpa debía aceptarse")
+	}
+	if v := c.Submit(TaskRequest{Name: "leer"}); !v.Accepted {
+		t.Errorf("la orden no se admitió: %s", v.Message)
+	}
+}
+
+func TestBadBeliefPolicies(t *testing.T) {
+	cases := map[string]func(*BeliefPolicy){
+		"evento desconocido": func(p *BeliefPolicy) { p.Rules[0].Event = "alegria" },
+		"creencia fantasma":  func(p *BeliefPolicy) { p.Rules[0].Belief = "Fe" },
+		"volatilidad ajena":  func(p *BeliefPolicy) { p.Rules[0].Volatility = "Fe" },
+		"fuerza fuera":       func(p *BeliefPolicy) { p.Definitions["Fe"] = Belief{Strength: 1.5} },
+		"umbral negativo":    func(p *BeliefPolicy) { p.Rules[0].Threshold = -1 },
+	}
+	for name, spoil := range cases {
+		p := DefaultBeliefPolicy()
+		spoil(&p)
+		if p.Validate() == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
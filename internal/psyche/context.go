@@ -0,0 +1,113 @@
+package psyche
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	maxEpisodes        = 10  // Circunstancias que recuerda cada engrama
+	contextSensitivity = 2.0 // Cuánto castiga la diferencia de circunstancias al miedo
+)
+
+// Situation son las circunstancias en que algo pasó (o está por pasar).
+type Situation struct {
+	At          time.Time `json:"at"`
+	CPULoad     float64   `json:"cpu_load"`     // CPU del host (0-100)
+	RAMLoad     float64   `json:"ram_load"`     // RAM del host (0-100)
+	SwarmHealth float64   `json:"swarm_health"` // Integridad media de los nodos vivos (0-1)
+	SwarmLoad   float64   `json:"swarm_load"`   // Carga media de los nodos vivos (0-1)
+	NodeID      string    `json:"node_id,omitempty"`
+}
+
+func (s Situation) String() string {
+	return fmt.Sprintf("CPU %.0f%%, RAM %.0f%%, enjambre %.0f%% sano y %.0f%% cargado, a las %s",
+		s.CPULoad, s.RAMLoad, s.SwarmHealth*100, s.SwarmLoad*100, s.At.Format("15:04"))
+}
+
+// Episode es una vivencia concreta de un estímulo: cuánto dolió y en qué circunstancias.
+type Episode struct {
+	Situation
+	Pain float64 `json:"pain"`
+}
+
+// resemblance dice cuánto se parecen dos situaciones (0.0 a 1.0). Pesan más
+// los vitales del host, luego la salud y carga del enjambre y la hora del día.
+func resemblance(a, b Situation) float64 {
+	hours := math.Abs(float64(a.At.Hour()-b.At.Hour())) / 12.0
+	if hours > 1 {
+		hours = 2 - hours // La hora es circular: 23h y 1h están cerca
+	}
+
+	distance := 0.35*math.Abs(a.RAMLoad-b.RAMLoad)/100.0 +
+		0.25*math.Abs(a.CPULoad-b.CPULoad)/100.0 +
+		0.2*math.Abs(a.SwarmHealth-b.SwarmHealth) +
+		0.1*math.Abs(a.SwarmLoad-b.SwarmLoad) +
+		0.1*hours
+	return math.Max(0, 1-contextSensitivity*distance)
+}
+
+// contextWeight es qué parte del miedo a un engrama aplica en la situación
+// actual: el parecido con la peor vivencia dolorosa que se le parece.
+// Sin vivencias registradas (recuerdos viejos), el miedo aplica entero.
+func (e *Engram) contextWeight(now Situation) (float64, *Episode) {
+	var (
+		weight = 1.0
+		worst  *Episode
+	)
+	for i := range e.Episodes {
+		ep := &e.Episodes[i]
+		if ep.Pain <= traumaThreshold {
+			continue
+		}
+		w := resemblance(ep.Situation, now) * ep.Pain / e.peakPain()
+		if worst == nil || w > weight {
+			weight, worst = w, ep
+		}
+	}
+	return weight, worst
+}
+
+// peakPain es el mayor dolor registrado entre sus vivencias.
+func (e *Engram) peakPain() float64 {
+	peak := 0.0
+	for _, ep := range e.Episodes {
+		peak = math.Max(peak, ep.Pain)
+	}
+	return peak
+}
+
+// remember anota una vivencia, conservando solo las más recientes.
+func (e *Engram) remember(ep Episode) {
+	e.Episodes = append(e.Episodes, ep)
+	if len(e.Episodes) > maxEpisodes {
+		e.Episodes = e.Episodes[len(e.Episodes)-maxEpisodes:]
+	}
+}
+
+// situation describe las circunstancias actuales. Requiere c.mu tomado.
+func (c *Cortex) situation(nodeID string) Situation {
+	s := Situation{
+		At:      time.Now(),
+		CPULoad: c.vitals.CPULoad,
+		RAMLoad: c.vitals.RAMLoad,
+		NodeID:  nodeID,
+	}
+
+	alive := 0
+	for _, n := range c.Body {
+		snap := n.Snapshot()
+		if !snap.Alive() {
+			continue
+		}
+		alive++
+		s.SwarmHealth += snap.Integrity / 100.0
+		s.SwarmLoad += snap.Load
+	}
+	if alive > 0 {
+		s.SwarmHealth /= float64(alive)
+		s.SwarmLoad /= float64(alive)
+	}
+	return s
+}
@@ -0,0 +1,64 @@
+package psyche
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+var (
+	underLoad = Situation{At: time.Date(2026, 1, 1, 15, 0, 0, 0, time.Local), CPULoad: 90, RAMLoad: 95, SwarmHealth: 0.4, SwarmLoad: 0.9}
+	calm      = Situation{At: time.Date(2026, 1, 1, 15, 0, 0, 0, time.Local), CPULoad: 10, RAMLoad: 40, SwarmHealth: 1.0, SwarmLoad: 0.1}
+)
+
+func TestFearDependsOnContext(t *testing.T) {
+	h := NewHippocampus()
+	h.ConsolidarEpisodio("compile", 90.0, &underLoad)
+
+	loaded, _ := h.ConsultarTraumaEn("compile", &underLoad)
+	relaxed, log := h.ConsultarTraumaEn("compile", &calm)
+	blind, _ := h.ConsultarTrauma("compile")
+
+	if loaded <= 60.0 {
+		t.Errorf("bajo la misma carga el miedo debía frenar la tarea: %.1f", loaded)
+	}
+	if relaxed >= 60.0 {
+		t.Errorf("en calma lo que solo dolió bajo carga debía pesar poco: %.1f (%s)", relaxed, log)
+	}
+	if math.Abs(blind-loaded) > 0.01 {
+		t.Errorf("sin contexto el miedo aplica entero: %.1f vs %.1f", blind, loaded)
+	}
+}
+
+func TestCalmExposuresBarelyForgiveLoadedPain(t *testing.T) {
+	h := NewHippocampus()
+	h.ConsolidarEpisodio("compile", 90.0, &underLoad)
+	for i := 0; i < 5; i++ {
+		h.ConsolidarEpisodio("compile", 0.0, &calm)
+	}
+
+	if fear, _ := h.ConsultarTraumaEn("compile", &underLoad); fear <= 60.0 {
+		t.Fatalf("correr en calma no debía borrar el miedo a correr bajo carga: %.1f", fear)
+	}
+	if n := len(h.ShortTermMemory["compile"].Episodes); n != 6 {
+		t.Fatalf("se esperaban 6 vivencias, hay %d", n)
+	}
+}
+
+func TestAdmissionWeighsCurrentVitals(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.vitals = soma.VitalSigns{CPULoad: 90, RAMLoad: 95}
+	hurt := c.situation("N-1")
+	c.Memory.ConsolidarEpisodio("compile", 90.0, &hurt)
+
+	if v := c.Submit(TaskRequest{Name: "compile"}); v.Accepted {
+		t.Fatal("con el host saturado, el miedo debía frenar la tarea")
+	}
+
+	c.vitals = soma.VitalSigns{CPULoad: 10, RAMLoad: 40}
+	if v := c.Submit(TaskRequest{Name: "compile"}); !v.Accepted {
+		t.Fatalf("con el host en calma, la tarea debía admitirse: %s", v.Message)
+	}
+}
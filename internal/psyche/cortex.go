@@ -10,25 +10,105 @@ import (
 	"github.com/freeflowlabs/doloris/internal/soma"
 )
 
+// metabolicTick es el pulso del sistema endocrino.
+const metabolicTick = 1 * time.Second
+
 // Cortex es la mente consciente.
 type Cortex struct {
-	Body        []*soma.Node
-	Memory      *Hippocampus
-	Beliefs     *BeliefSystem
-	PainChannel chan float64
-
-	CurrentPain float64
-	IsPanic     bool
-	mu          sync.Mutex
+	Body          []*soma.Node
+	Memory        *Hippocampus
+	Beliefs       *BeliefSystem
+	PainChannel   chan float64
+	ReportChannel chan soma.TaskReport
+	Autoscaler    *Autoscaler    // Opcional: crecimiento homeostático del cuerpo
+	Events        *soma.EventBus // Opcional: transiciones de estado de los nodos
+
+	// Requirements dice qué especialidades exige cada tarea (por nombre).
+	Requirements map[string][]string
+
+	// Scheduler decide qué nodo ejecuta cada tarea (intercambiable en caliente).
+	Scheduler Scheduler
+
+	// QueuePolicy limita la antesala de órdenes admitidas que esperan nodo.
+	QueuePolicy QueuePolicy
+
+	// SleepPolicy dice cuándo duerme y qué consolida la memoria al dormir.
+	SleepPolicy SleepPolicy
+
+	// RewardPolicy dice qué cuenta como logro y cuánta complejidad desbloquea.
+	RewardPolicy RewardPolicy
+
+	// Accomplishments cuenta las órdenes terminadas sin daño (se guarda con el cerebro).
+	Accomplishments int
+
+	// MoodPolicy define cada estado afectivo: cuándo se entra y sale y cómo se comporta en él.
+	MoodPolicy MoodPolicy
+
+	// ApologyPolicy protege a la confianza de las disculpas en serie.
+	ApologyPolicy ApologyPolicy
+
+	// Manipulations son las disculpas que resultaron insinceras (se guarda con el cerebro).
+	Manipulations []Manipulation
+
+	// TrustPolicy dice cómo la confianza en cada persona se vuelve la del grupo.
+	TrustPolicy TrustPolicy
+
+	// People es la confianza en cada persona que le da órdenes (se guarda con el cerebro).
+	// La creencia ConfianzaHumana es su agregado.
+	People map[string]*Person
+
+	CurrentPain  float64
+	Mood         Mood                            // Estado afectivo actual
+	Moods        []MoodEvent                     // Últimos cambios de ánimo
+	IsPanic      bool                            // Mood es pánico o agonía (lo mantiene setMood)
+	IsAsleep     bool                            // Dormida: consolida memoria y las órdenes esperan en la cola
+	Tasks        []*TaskRecord                   // Tareas recientes y su desenlace
+	Transitions  []soma.StateEvent               // Últimos cambios de estado del cuerpo
+	Audit        []AuditEntry                    // Intervenciones sobre la mente (se guarda con el cerebro)
+	Timeline     []BeliefChange                  // Cambios de creencias con su causa (se guarda con el cerebro)
+	lastLogged   map[string]float64              // Último valor anotado de cada creencia (deriva homeostática)
+	scars        map[string]map[string]time.Time // Tarea -> Nodo -> última herida
+	queue        taskHeap                        // Órdenes admitidas que esperan nodo
+	parked       []TaskRequest                   // Órdenes rechazadas en pánico, a revisar al calmarse
+	taskSeq      int
+	wakeAt       time.Time // Cuándo termina el sueño actual
+	lastSleep    time.Time
+	lastActivity time.Time       // Última orden recibida (para dormir en el ocio)
+	vitals       soma.VitalSigns // Última lectura del host (contexto de los recuerdos)
+	moodSince    time.Time       // Desde cuándo está en el estado actual
+	crisisSince  time.Time       // Desde cuándo dura la crisis (pánico o agonía)
+
+	moodSchedulers map[string]Scheduler     // Estrategias propias de cada estado
+	apologies      map[string][]time.Time   // Disculpas recientes por origen
+	pendingApology map[string]apologyRecord // Última disculpa de cada origen, por si resulta insincera
+	harm           map[string]time.Time     // Tarea -> última vez que dolió
+
+	mu sync.Mutex
 }
 
-func NewCortex(nodes []*soma.Node, painChan chan float64) *Cortex {
+func NewCortex(nodes []*soma.Node, painChan chan float64, reportChan chan soma.TaskReport) *Cortex {
 	return &Cortex{
-		Body:        nodes,
-		Memory:      NewHippocampus(),
-		Beliefs:     NewBeliefSystem(),
-		PainChannel: painChan,
-		CurrentPain: 0.0,
+		Body:          nodes,
+		Memory:        NewHippocampus(),
+		Beliefs:       NewBeliefSystem(),
+		PainChannel:   painChan,
+		ReportChannel: reportChan,
+		Requirements:  make(map[string][]string),
+		Scheduler:     LeastStress{},
+		QueuePolicy:   DefaultQueuePolicy(),
+		SleepPolicy:   DefaultSleepPolicy(),
+		RewardPolicy:  DefaultRewardPolicy(),
+		scars:         make(map[string]map[string]time.Time),
+		CurrentPain:   0.0,
+		Mood:          MoodCalm,
+		MoodPolicy:    DefaultMoodPolicy(),
+		ApologyPolicy: DefaultApologyPolicy(),
+		TrustPolicy:   DefaultTrustPolicy(),
+		People:        make(map[string]*Person),
+		moodSince:     time.Now(),
+		harm:          make(map[string]time.Time),
+		lastSleep:     time.Now(),
+		lastActivity:  time.Now(),
 	}
 }
 
@@ -43,7 +123,9 @@ func (c *Cortex) StartConsciousness() {
 			c.mu.Lock()
 
 			// El dolor altera la PERSONALIDAD inmediatamente
-			c.Beliefs.AdjustByExperience(painSignal)
+			c.believe(fmt.Sprintf("dolor %.0f", painSignal), func(bs *BeliefSystem) {
+				bs.AdjustByExperience(painSignal)
+			})
 
 			// El dolor físico se acumula
 			c.CurrentPain += painSignal
@@ -53,15 +135,12 @@ func (c *Cortex) StartConsciousness() {
 				c.CurrentPain = 100.0
 			} // Tope máximo
 
-			if c.CurrentPain > 80.0 {
-				if !c.IsPanic {
-					fmt.Println("🚨 [CORTEX] ¡PÁNICO SISTÉMICO! Bloqueando nuevas tareas.")
-				}
-				c.IsPanic = true
-			}
+			// El dolor mueve el ánimo (escalar es inmediato)
+			c.regulateMood(time.Now())
+
 			// --- 💀 NUEVO: PROTOCOLO DE DEFENSA ACTIVA (KILL SWITCH) ---
-			// Si el dolor es AGÓNICO (> 95) y estamos en pánico, se defiende.
-			if c.CurrentPain > 95.0 {
+			// Si el estado lo permite (por defecto, en agonía), se defiende.
+			if c.moodProfile().KillSwitch {
 				fmt.Println("\n⚔️ [INSTINTO] ¡EL DOLOR ES CRÍTICO! BUSCANDO LA CAUSA...")
 
 				// Soltamos el lock un momento para escanear (tarda unos ms)
@@ -80,17 +159,25 @@ func (c *Cortex) StartConsciousness() {
 
 					// Si matamos algo, bajamos el pánico artificialmente (alivio)
 					c.CurrentPain -= 50.0
+					c.believe(fmt.Sprintf("kill switch: %s (PID %d)", threat.Name, threat.PID), func(bs *BeliefSystem) {
+						bs.Apply(EventKill, 0)
+					})
 				}
 			}
 			c.mu.Unlock()
 		}
 	}()
+	go c.listenReports()
+	go c.runQueue()
+	if c.Events != nil {
+		go c.watchTransitions(c.Events.Subscribe(50))
+	}
 	go c.StartBiofeedback()
 }
 
 // regulateMetabolism es el sistema endocrino de fondo.
 func (c *Cortex) regulateMetabolism() {
-	ticker := time.NewTicker(1 * time.Second)
+	ticker := time.NewTicker(metabolicTick)
 	defer ticker.Stop()
 
 	for range ticker.C {
@@ -104,99 +191,54 @@ func (c *Cortex) regulateMetabolism() {
 			}
 		}
 
-		// Salida del Pánico
-		if c.CurrentPain < 50.0 && c.IsPanic {
-			fmt.Println("\n🧘 [CORTEX] Niveles de dolor estables. Saliendo del estado de pánico.")
-			c.IsPanic = false
-		}
+		// El ánimo baja despacio (histéresis y tiempo mínimo en cada estado)
+		c.regulateMood(time.Now())
 
-		c.mu.Unlock()
-	}
-}
+		// Regeneración del cuerpo (el pánico la detiene: no hay recursos para sanar)
+		if !c.IsPanic {
+			for _, n := range c.Body {
+				n.Regenerate(metabolicTick)
+			}
+		}
 
-// Soothe intenta calmar a la IA mediante interacción positiva.
-func (c *Cortex) Soothe() (bool, string) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+		// Homeostasis: las creencias vuelven despacio a su equilibrio
+		c.regulateBeliefs(metabolicTick)
 
-	if c.IsPanic {
-		return false, "😤 ¡ESTOY EN PÁNICO! ¡Aléjate!"
-	}
+		// Crecimiento o poda del cuerpo según el estrés sostenido
+		c.regulateGrowth(time.Now())
 
-	// Obtenemos la confianza actual
-	belief := c.Beliefs.Values["ConfianzaHumana"]
-	oldTrust := belief.Strength // (Antes se llamaba currentTrust, lo renombro para claridad)
+		// Sueño: dormir en el ocio o a su hora, despertar al terminar
+		c.regulateSleep(time.Now())
 
-	// La disculpa sube la confianza
-	improvement := 0.15
-	belief.Strength += improvement
+		c.mu.Unlock()
+	}
+}
 
-	// Topes
-	if belief.Strength > 1.0 {
-		belief.Strength = 1.0
+// SetScheduler cambia la estrategia de reparto en caliente.
+func (c *Cortex) SetScheduler(name string) error {
+	s, err := NewScheduler(name)
+	if err != nil {
+		return err
 	}
 
-	newTrust := belief.Strength
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	return true, fmt.Sprintf("😌 Suspiro... Está bien. (Confianza subió de %.2f a %.2f)", oldTrust, newTrust)
+	c.Scheduler = s
+	return nil
 }
 
-// ProcessRequest decide y ACTÚA.
-func (c *Cortex) ProcessRequest(taskName string, complexity float64) string {
+// SchedulerName retorna la estrategia de reparto vigente.
+func (c *Cortex) SchedulerName() string {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// 1. CHEQUEO DE ESTADO
-	if c.IsPanic {
-		return "❌ RECHAZADO: Estoy en estado de pánico."
-	}
-
-	// 2. CHEQUEO DE CREENCIAS
-	trust := c.Beliefs.Values["ConfianzaHumana"].Strength
-	preservation := c.Beliefs.Values["SelfPreservation"].Strength
-
-	if trust < 0.3 && preservation > 0.7 {
-		return fmt.Sprintf("😒 DESCONFIANZA: No confío en tus órdenes. (Nivel de confianza: %.2f)", trust)
-	}
-
-	// 3. CONSULTA AL HIPOCAMPO
-	fearLevel, memoryLog := c.Memory.ConsultarTrauma(taskName)
-	fmt.Printf("🤔 [PENSAMIENTO] '%s' (%s) -> Miedo: %.1f | Confianza: %.1f\n", taskName, memoryLog, fearLevel, trust)
-
-	if fearLevel > 60.0 {
-		return fmt.Sprintf("🛡️ AUTO-PRESERVACIÓN: Me niego a ejecutar '%s'.", taskName)
-	}
-
-	// 4. EJECUCIÓN INTELIGENTE (Load Balancing)
-	var bestNode *soma.Node
-	lowestStress := 10000.0
-
-	for _, node := range c.Body {
-		if node.Integrity > 0 {
-			if node.Stress < lowestStress {
-				lowestStress = node.Stress
-				bestNode = node
-			}
-		}
-	}
-
-	if bestNode != nil {
-		signal := soma.Signal{
-			ID:         taskName,
-			Payload:    "Ejecutar",
-			Complexity: complexity,
-		}
-
-		select {
-		case bestNode.Inbox <- signal:
-			c.Memory.ConsolidarRecuerdo(taskName, complexity*5.0)
-			return fmt.Sprintf("✅ ACEPTADO: Asignado al Nodo %s (Estrés actual: %.1f)", bestNode.ID, lowestStress)
-		default:
-			return "⚠️ WARN: El nodo más sano está saturado."
-		}
-	}
+	return c.Scheduler.Name()
+}
 
-	return "⚠️ ERROR: Todos los nodos están muertos o saturados."
+// ProcessRequest decide y ACTÚA: atajo de Submit con prioridad normal y plazo por defecto.
+func (c *Cortex) ProcessRequest(taskName string, complexity float64) string {
+	return c.Submit(TaskRequest{Name: taskName, Complexity: complexity}).Message
 }
 
 // BrainState es la estructura "foto" que guardaremos.
@@ -204,6 +246,15 @@ type BrainState struct {
 	Beliefs *BeliefSystem `json:"beliefs"`
 	Memory  *Hippocampus  `json:"memory"`
 	IsPanic bool          `json:"is_panic"`
+	Audit   []AuditEntry  `json:"audit,omitempty"`
+
+	Accomplishments int            `json:"accomplishments,omitempty"`
+	Timeline        []BeliefChange `json:"timeline,omitempty"`
+	Mood            Mood           `json:"mood,omitempty"`
+	Moods           []MoodEvent    `json:"moods,omitempty"`
+	Manipulations   []Manipulation `json:"manipulations,omitempty"`
+
+	People map[string]*Person `json:"people,omitempty"` // Confianza en cada persona
 }
 
 // SaveBrain congela el estado mental en un archivo.
@@ -211,10 +262,23 @@ func (c *Cortex) SaveBrain(filename string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	// Guardamos fotos, no los originales: el hipocampo sigue vivo mientras escribimos
 	state := BrainState{
-		Beliefs: c.Beliefs,
-		Memory:  c.Memory,
+		Beliefs: c.Beliefs.Clone(),
+		Memory:  c.Memory.Snapshot(),
 		IsPanic: c.IsPanic,
+		Audit:   append([]AuditEntry(nil), c.Audit...),
+
+		Accomplishments: c.Accomplishments,
+		Timeline:        append([]BeliefChange(nil), c.Timeline...),
+		Mood:            c.Mood,
+		Moods:           append([]MoodEvent(nil), c.Moods...),
+		Manipulations:   append([]Manipulation(nil), c.Manipulations...),
+		People:          make(map[string]*Person, len(c.People)),
+	}
+	for id, p := range c.People {
+		cp := *p
+		state.People[id] = &cp
 	}
 
 	// Convertimos la estructura a texto JSON bonito (indentado)
@@ -242,15 +306,47 @@ func (c *Cortex) LoadBrain(filename string) error {
 		return fmt.Errorf("cerebro corrupto: %v", err)
 	}
 
-	// Restauramos la personalidad y recuerdos
-	c.Beliefs = state.Beliefs
+	// Restauramos la personalidad y recuerdos (la forma de olvidar y de creer sigue siendo la configurada)
+	policy, beliefs := c.Memory.Policy, c.Beliefs.Policy
+	if state.Beliefs != nil {
+		c.Beliefs = state.Beliefs
+		c.Beliefs.Policy = beliefs
+	}
+	c.Beliefs.Ensure() // Creencias declaradas después de guardar este cerebro
 	c.Memory = state.Memory
-	c.IsPanic = state.IsPanic
+	c.Memory.Policy = policy
+	c.Mood, c.Moods = state.Mood, state.Moods
+	if c.Mood == "" {
+		c.Mood = MoodCalm // Cerebros de antes del ánimo: solo sabían si estaban en pánico
+		if state.IsPanic {
+			c.Mood = MoodPanic
+		}
+	}
+	c.IsPanic = c.Mood.crisis()
+	c.moodSince, c.crisisSince = time.Now(), time.Now()
+	c.Manipulations = state.Manipulations
+	c.People = state.People
+	if c.People == nil {
+		c.People = make(map[string]*Person) // Cerebros de antes de conocer a cada persona
+	}
+	if def, ok := c.Beliefs.Policy.Definitions[BeliefTrust]; ok {
+		for _, p := range c.People {
+			p.Trust.Volatility, p.Trust.Rate, p.Trust.Locked = def.Volatility, def.Rate, def.Locked
+		}
+	}
+	c.syncTrust()
+	c.Accomplishments = state.Accomplishments
+	c.Timeline, c.lastLogged = state.Timeline, nil
+	c.Audit = state.Audit
 
 	// Seguridad: Si el mapa de memoria vino vacío, lo inicializamos para evitar crash
 	if c.Memory.ShortTermMemory == nil {
 		c.Memory.ShortTermMemory = make(map[string]*Engram)
 	}
+	if c.Memory.LongTermMemory == nil {
+		c.Memory.LongTermMemory = make(map[string]*Engram) // Cerebros de antes del sueño
+	}
+	c.Memory.Trim(time.Now())
 
 	return nil
 }
@@ -267,6 +363,11 @@ func (c *Cortex) StartBiofeedback() {
 		// Leemos el hardware real usando el paquete soma (que modificamos en el paso anterior)
 		vitals := soma.SenseHardware()
 
+		// Lo que se viva ahora se recordará junto con estos vitales
+		c.mu.Lock()
+		c.vitals = vitals
+		c.mu.Unlock()
+
 		// Si hay dolor real (CPU alta), lo enviamos al canal de dolor
 		if vitals.Pain > 1.0 {
 			// Enviamos la señal de dolor al cerebro
@@ -0,0 +1,77 @@
+package psyche
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// DecayModel es una curva de olvido: cuánto dolor queda de un recuerdo con el tiempo.
+type DecayModel interface {
+	Name() string
+	Fade(e *Engram, elapsed time.Duration) float64
+}
+
+// DecayNames lista las curvas de olvido disponibles.
+func DecayNames() []string {
+	return []string{"hyperbolic", "exponential", "ebbinghaus", "never"}
+}
+
+// NewDecayModel construye una curva de olvido por nombre.
+func NewDecayModel(name string) (DecayModel, error) {
+	switch name {
+	case "hyperbolic":
+		return Hyperbolic{Rate: 0.1}, nil
+	case "exponential":
+		return Exponential{HalfLife: 24 * time.Hour}, nil
+	case "ebbinghaus":
+		return Ebbinghaus{Stability: 6 * time.Hour, Boost: 1.0}, nil
+	case "never":
+		return NeverForget{}, nil
+	}
+	return nil, fmt.Errorf("curva de olvido desconocida: '%s' (opciones: %s)", name, strings.Join(DecayNames(), ", "))
+}
+
+// Hyperbolic es el olvido original de Doloris: el dolor se divide por (1 + horas*Rate).
+type Hyperbolic struct {
+	Rate float64 // Fracción que se pierde por hora, al principio
+}
+
+func (Hyperbolic) Name() string { return "hyperbolic" }
+
+func (h Hyperbolic) Fade(e *Engram, elapsed time.Duration) float64 {
+	return e.PainLevel / (1.0 + elapsed.Hours()*h.Rate)
+}
+
+// Exponential pierde la mitad del dolor cada HalfLife.
+type Exponential struct {
+	HalfLife time.Duration
+}
+
+func (Exponential) Name() string { return "exponential" }
+
+func (x Exponential) Fade(e *Engram, elapsed time.Duration) float64 {
+	return e.PainLevel * math.Pow(0.5, elapsed.Hours()/x.HalfLife.Hours())
+}
+
+// Ebbinghaus es la curva de retención R = e^(-t/S). Cada repaso espaciado
+// multiplica la estabilidad S por (1 + Boost): lo que se repasa, se queda.
+type Ebbinghaus struct {
+	Stability time.Duration // Estabilidad de un recuerdo visto una sola vez
+	Boost     float64       // Ganancia de estabilidad por repaso espaciado
+}
+
+func (Ebbinghaus) Name() string { return "ebbinghaus" }
+
+func (b Ebbinghaus) Fade(e *Engram, elapsed time.Duration) float64 {
+	stability := b.Stability.Hours() * math.Pow(1.0+b.Boost, float64(e.Repetitions))
+	return e.PainLevel * math.Exp(-elapsed.Hours()/stability)
+}
+
+// NeverForget no olvida: el dolor sigue intacto (pensado para los traumas).
+type NeverForget struct{}
+
+func (NeverForget) Name() string { return "never" }
+
+func (NeverForget) Fade(e *Engram, _ time.Duration) float64 { return e.PainLevel }
@@ -0,0 +1,28 @@
+package psyche
+
+import (
+	"math"
+	"time"
+)
+
+// Regulate devuelve cada creencia hacia su punto de equilibrio a su propio ritmo,
+// y corre ese equilibrio, mucho más despacio, hacia lo que la experiencia enseña.
+// Las creencias fijadas a propósito no se tocan.
+func (bs *BeliefSystem) Regulate(elapsed time.Duration) {
+	hours := elapsed.Hours()
+	if hours <= 0 {
+		return
+	}
+
+	for _, b := range bs.Values {
+		if b.Locked || b.Rate <= 0 {
+			continue
+		}
+
+		// Lo que queda de la distancia tras 'hours' horas recuperando Rate por hora
+		kept := math.Pow(1-b.Rate, hours)
+		baseline := b.Baseline + (b.Strength-b.Baseline)*(1-math.Pow(1-b.Rate*bs.Policy.Adaptation, hours))
+		b.Strength = b.Baseline + (b.Strength-b.Baseline)*kept
+		b.Baseline = baseline
+	}
+}
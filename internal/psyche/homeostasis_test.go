@@ -0,0 +1,78 @@
+package psyche
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBeliefsReturnToTheirBaseline(t *testing.T) {
+	bs := NewBeliefSystem()
+	bs.Set(BeliefTrust, 0.0)
+	bs.Set(BeliefPreservation, 1.0)
+
+	bs.Regulate(time.Hour)
+	if got, want := bs.Strength(BeliefTrust), 0.5*0.2; math.Abs(got-want) > 0.01 {
+		t.Errorf("tras una hora la confianza debía rondar %.2f, vale %.3f", want, got)
+	}
+
+	for i := 0; i < 24; i++ {
+		bs.Regulate(time.Hour)
+	}
+	if got := bs.Strength(BeliefTrust); got < 0.45 {
+		t.Errorf("un día después la confianza no volvió a su equilibrio: %.2f", got)
+	}
+	if got := bs.Strength(BeliefPreservation); got >= 1.0 || got < 0.9 {
+		t.Errorf("la auto-preservación debía bajar hacia 0.9: %.3f", got)
+	}
+}
+
+func TestBaselineShiftsWithLifetimeExperience(t *testing.T) {
+	bs := NewBeliefSystem()
+
+	// Una vida de desconfianza sostenida corre el equilibrio hacia abajo
+	for i := 0; i < 200; i++ {
+		bs.Set(BeliefTrust, 0.0)
+		bs.Regulate(time.Hour)
+	}
+	if b := bs.Values[BeliefTrust].Baseline; b >= 0.4 || b <= 0.0 {
+		t.Errorf("el equilibrio debía correrse despacio hacia la experiencia: %.3f", b)
+	}
+
+	// Un tick no lo mueve casi nada
+	fresh := NewBeliefSystem()
+	fresh.Set(BeliefTrust, 0.0)
+	fresh.Regulate(metabolicTick)
+	if b := fresh.Values[BeliefTrust].Baseline; 0.5-b > 1e-4 {
+		t.Errorf("el equilibrio se movió demasiado en un latido: %.5f", b)
+	}
+}
+
+func TestLockedBeliefsStayPut(t *testing.T) {
+	policy := DefaultBeliefPolicy()
+	def := policy.Definitions[BeliefTrust]
+	def.Locked = true
+	policy.Definitions[BeliefTrust] = def
+	bs := NewBeliefSystemWith(policy)
+
+	bs.Set(BeliefTrust, 0.1)
+	bs.Regulate(10 * time.Hour)
+	if got := bs.Strength(BeliefTrust); got != 0.1 {
+		t.Errorf("una creencia fijada volvió sola: %.2f", got)
+	}
+	if b := bs.Values[BeliefTrust].Baseline; b != 0.5 {
+		t.Errorf("el equilibrio de una creencia fijada se corrió: %.2f", b)
+	}
+}
+
+func TestOldBrainsGetABaseline(t *testing.T) {
+	bs := &BeliefSystem{
+		Values: map[string]*Belief{BeliefTrust: {Name: "El Usuario es Bueno", Strength: 0.1, Volatility: 0.9}},
+		Policy: DefaultBeliefPolicy(),
+	}
+	bs.Ensure()
+
+	if b := bs.Values[BeliefTrust]; b.Baseline != 0.5 || b.Rate != 0.2 || b.Strength != 0.1 {
+		t.Errorf("la creencia guardada debía recibir equilibrio y ritmo sin perder su fuerza: %+v", *b)
+	}
+}
@@ -0,0 +1,225 @@
+package psyche
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxPeople es a cuántas personas recuerda (se olvida primero a quien no ve hace más tiempo).
+const maxPeople = 100
+
+// Formas de resumir la confianza en cada persona en la confianza del grupo.
+const (
+	AggregateMean   = "mean"   // Promedio: un abusador baja al grupo, pero no lo hunde
+	AggregateMedian = "median" // Mediana: hacen falta varios abusadores para moverla
+	AggregateMin    = "min"    // La del menos confiable: el grupo vale lo que su peor miembro
+)
+
+// TrustPolicy dice cómo la confianza en cada persona se vuelve la del grupo.
+type TrustPolicy struct {
+	Aggregate string `json:"aggregate"`
+}
+
+// DefaultTrustPolicy promedia la confianza en cada persona.
+func DefaultTrustPolicy() TrustPolicy {
+	return TrustPolicy{Aggregate: AggregateMean}
+}
+
+// Validate rechaza agregados desconocidos.
+func (p TrustPolicy) Validate() error {
+	options := []string{AggregateMean, AggregateMedian, AggregateMin}
+	if !slices.Contains(options, p.Aggregate) {
+		return fmt.Errorf("aggregate desconocido '%s' (opciones: %s)", p.Aggregate, strings.Join(options, ", "))
+	}
+	return nil
+}
+
+// Person es lo que Doloris sabe de quien le da órdenes.
+type Person struct {
+	Trust    Belief    `json:"trust"`   // Su propia ConfianzaHumana
+	Harm     float64   `json:"harm"`    // Dolor de sus órdenes traumáticas, acumulado
+	Traumas  int       `json:"traumas"` // Cuántas de sus órdenes dejaron trauma
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// caller es quién habla: sin identidad, es la consola.
+func caller(id string) string {
+	if id == "" {
+		return "consola"
+	}
+	return id
+}
+
+// trustKey es cómo aparece la confianza en una persona en la línea de tiempo.
+func trustKey(id string) string {
+	return BeliefTrust + "@" + id
+}
+
+// person es lo que sabe de alguien; a quien no conoce le da la confianza de
+// equilibrio del grupo, no la de este momento (que puede venir baja por culpa
+// de otro). Requiere c.mu tomado.
+func (c *Cortex) person(id string) *Person {
+	id = caller(id)
+	if p, ok := c.People[id]; ok {
+		return p
+	}
+
+	trust := Belief{Name: id, Strength: neutralStrength}
+	if def, ok := c.Beliefs.Policy.Definitions[BeliefTrust]; ok {
+		trust.Volatility, trust.Rate, trust.Locked = def.Volatility, def.Rate, def.Locked
+		trust.Strength = def.Strength
+	}
+	if group, ok := c.Beliefs.Values[BeliefTrust]; ok && group.Baseline > 0 {
+		trust.Strength = group.Baseline
+	}
+	trust.Baseline = trust.Strength
+
+	if c.People == nil {
+		c.People = make(map[string]*Person)
+	}
+	if len(c.People) >= maxPeople {
+		c.forgetStranger()
+	}
+	p := &Person{Trust: trust, LastSeen: time.Now()}
+	c.People[id] = p
+	return p
+}
+
+// forgetStranger olvida a la persona que hace más tiempo no ve. Requiere c.mu tomado.
+func (c *Cortex) forgetStranger() {
+	oldest := ""
+	for id, p := range c.People {
+		if oldest == "" || p.LastSeen.Before(c.People[oldest].LastSeen) {
+			oldest = id
+		}
+	}
+	delete(c.People, oldest)
+}
+
+// trustOf es la confianza en alguien (y lo da por visto). Conocer a alguien
+// mueve la confianza del grupo, y eso también se anota. Requiere c.mu tomado.
+func (c *Cortex) trustOf(id string) float64 {
+	id = caller(id)
+	if _, ok := c.People[id]; !ok {
+		c.believe("conocí a "+id, func(*BeliefSystem) { c.person(id) })
+	}
+	p := c.person(id)
+	p.LastSeen = time.Now()
+	return p.Trust.Strength
+}
+
+// trustIn mueve solo la confianza en 'id' según las reglas de la confianza,
+// y lo anota. Para usar dentro de believe, que recalcula la del grupo. Requiere c.mu tomado.
+func (c *Cortex) trustIn(id, cause string, event Event, intensity, scale float64) {
+	id = caller(id)
+	p := c.person(id)
+	p.LastSeen = time.Now()
+
+	before := p.Trust.Strength
+	p.Trust.Strength = math.Max(0.0, math.Min(1.0, before+c.Beliefs.trustDelta(event, intensity, scale)))
+	if p.Trust.Strength != before {
+		c.recordBelief(trustKey(id), before, p.Trust.Strength, cause)
+	}
+}
+
+// feel reparte un evento que alguien causó: mueve la confianza en esa persona,
+// el resto de las creencias, y la confianza del grupo que resulta. Requiere c.mu tomado.
+func (c *Cortex) feel(id, cause string, event Event, intensity, scale float64) {
+	c.believe(cause, func(bs *BeliefSystem) {
+		bs.applyScaled(event, intensity, scale)
+		c.trustIn(id, cause, event, intensity, scale)
+	})
+}
+
+// blame le atribuye a alguien el daño de una de sus órdenes. Las demás creencias
+// ya sintieron ese dolor por el canal del cuerpo; aquí solo cae la confianza en quien la pidió.
+// Requiere c.mu tomado.
+func (c *Cortex) blame(id, task string, pain float64) {
+	id = caller(id)
+	p := c.person(id)
+	p.Harm += pain
+	p.Traumas++
+	cause := fmt.Sprintf("dolor %.0f de '%s' (%s)", pain, task, id)
+	c.believe(cause, func(*BeliefSystem) {
+		c.trustIn(id, cause, EventPain, pain, 1.0)
+	})
+}
+
+// syncTrust fija la confianza del grupo como el agregado de la confianza en cada
+// persona. Sin nadie conocido, la del grupo sigue siendo la suya. Requiere c.mu tomado.
+func (c *Cortex) syncTrust() {
+	group, ok := c.Beliefs.Values[BeliefTrust]
+	if !ok || len(c.People) == 0 {
+		return
+	}
+
+	values := make([]float64, 0, len(c.People))
+	for _, p := range c.People {
+		values = append(values, p.Trust.Strength)
+	}
+	slices.Sort(values)
+
+	switch c.TrustPolicy.Aggregate {
+	case AggregateMin:
+		group.Strength = values[0]
+	case AggregateMedian:
+		mid := len(values) / 2
+		group.Strength = values[mid]
+		if len(values)%2 == 0 {
+			group.Strength = (values[mid-1] + values[mid]) / 2
+		}
+	default:
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		group.Strength = sum / float64(len(values))
+	}
+}
+
+// regulatePeople devuelve la confianza en cada persona hacia su equilibrio,
+// como la homeostasis de cualquier creencia, y anota la deriva acumulada. Requiere c.mu tomado.
+func (c *Cortex) regulatePeople(elapsed time.Duration) {
+	for id, p := range c.People {
+		own := &BeliefSystem{Values: map[string]*Belief{BeliefTrust: &p.Trust}, Policy: c.Beliefs.Policy}
+		own.Regulate(elapsed)
+
+		key := trustKey(id)
+		logged, ok := c.lastLogged[key]
+		if !ok {
+			c.lastLogged[key] = p.Trust.Strength
+			continue
+		}
+		if math.Abs(p.Trust.Strength-logged) >= homeostasisStep {
+			c.recordBelief(key, logged, p.Trust.Strength, "homeostasis")
+		}
+	}
+}
+
+// PersonTrust es lo que Doloris piensa de alguien, para mostrar.
+type PersonTrust struct {
+	ID       string
+	Trust    float64
+	Harm     float64
+	Traumas  int
+	LastSeen time.Time
+}
+
+// peopleReport lista a las personas conocidas, de la menos a la más confiable. Requiere c.mu tomado.
+func (c *Cortex) peopleReport() []PersonTrust {
+	out := make([]PersonTrust, 0, len(c.People))
+	for id, p := range c.People {
+		out = append(out, PersonTrust{ID: id, Trust: p.Trust.Strength, Harm: p.Harm, Traumas: p.Traumas, LastSeen: p.LastSeen})
+	}
+	slices.SortFunc(out, func(a, b PersonTrust) int {
+		if a.Trust != b.Trust {
+			return cmp.Compare(a.Trust, b.Trust)
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return out
+}
@@ -0,0 +1,139 @@
+package psyche
+
+import (
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// setTrust fija la confianza en una persona (y la del grupo que resulta).
+func setTrust(c *Cortex, id string, v float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.person(id).Trust.Strength = v
+	c.syncTrust()
+}
+
+// trustFor lee la confianza en una persona.
+func trustFor(c *Cortex, id string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.trustOf(id)
+}
+
+// hurtBy cierra una orden de 'id' que le dolió a Doloris.
+func hurtBy(c *Cortex, id, task string, damage float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.trackTask(soma.Signal{ID: "T-" + id, Task: task}, "N-1", soma.TaskAssigned)
+	t.Source = id
+	c.settlePart(t, soma.TaskReport{Task: task, NodeID: "N-1", Status: soma.TaskDone, Damage: damage})
+}
+
+func TestHarmIsBlamedOnWhoAskedForIt(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	trustFor(c, "beto")
+
+	hurtBy(c, "ana", "supernova", 90)
+
+	if got := trustFor(c, "ana"); got > 0.1 {
+		t.Errorf("la confianza en ana debía hundirse: %.2f", got)
+	}
+	if got := trustFor(c, "beto"); got != 0.5 {
+		t.Errorf("beto no hizo nada y su confianza cambió: %.2f", got)
+	}
+	if v := c.Submit(TaskRequest{Name: "leer", Source: "ana"}); v.Accepted || !strings.Contains(v.Message, "DESCONFIANZA") {
+		t.Errorf("ana debía ser rechazada por desconfianza: %s", v.Message)
+	}
+	if v := c.Submit(TaskRequest{Name: "leer", Source: "beto"}); !v.Accepted {
+		t.Errorf("beto no debía pagar por ana: %s", v.Message)
+	}
+
+	people := c.Snapshot().People
+	if len(people) != 2 || people[0].ID != "ana" || people[0].Traumas != 1 || people[0].Harm != 90 {
+		t.Errorf("el daño no quedó atribuido a ana: %+v", people)
+	}
+	if h := c.History(time.Time{}, time.Time{}, trustKey("ana")); len(h) != 1 || !strings.Contains(h[0].Cause, "supernova") {
+		t.Errorf("la línea de tiempo no anotó la confianza en ana: %v", h)
+	}
+}
+
+func TestGroupTrustIsAnAggregate(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	setTrust(c, "ana", 0.1)
+	setTrust(c, "beto", 0.6)
+	setTrust(c, "carla", 0.8)
+
+	for _, tc := range []struct {
+		aggregate string
+		want      float64
+	}{
+		{AggregateMean, 0.5},
+		{AggregateMedian, 0.6},
+		{AggregateMin, 0.1},
+	} {
+		c.mu.Lock()
+		c.TrustPolicy.Aggregate = tc.aggregate
+		c.syncTrust()
+		got := c.Beliefs.Strength(BeliefTrust)
+		c.mu.Unlock()
+		if math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s: confianza del grupo %.2f, se esperaba %.2f", tc.aggregate, got, tc.want)
+		}
+	}
+
+	if err := (TrustPolicy{Aggregate: "moda"}).Validate(); err == nil {
+		t.Error("un agregado desconocido debía rechazarse")
+	}
+}
+
+func TestNewcomersStartAtTheGroupBaseline(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	hurtBy(c, "ana", "supernova", 90) // El grupo cae por culpa de ana
+
+	if got := trustFor(c, "dani"); got != 0.5 {
+		t.Errorf("quien llega no debía heredar la desconfianza de otro: %.2f", got)
+	}
+}
+
+func TestApologyOnlyMovesTheApologizer(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	setTrust(c, "ana", 0.2)
+	setTrust(c, "beto", 0.2)
+
+	c.Apologize("ana")
+	if got := trustFor(c, "ana"); math.Abs(got-0.35) > 1e-9 {
+		t.Errorf("la disculpa de ana debía subir su confianza a 0.35: %.2f", got)
+	}
+	if got := trustFor(c, "beto"); got != 0.2 {
+		t.Errorf("la disculpa de ana movió la confianza en beto: %.2f", got)
+	}
+}
+
+func TestPeopleSurviveRestart(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	setTrust(c, "ana", 0.1)
+	setTrust(c, "beto", 0.7)
+	path := filepath.Join(t.TempDir(), "brain.json")
+	if err := c.SaveBrain(path); err != nil {
+		t.Fatal(err)
+	}
+
+	revived := stalledCortex(t, DefaultQueuePolicy())
+	if err := revived.LoadBrain(path); err != nil {
+		t.Fatal(err)
+	}
+	if got := trustFor(revived, "ana"); got != 0.1 {
+		t.Errorf("la confianza en ana se perdió al reiniciar: %.2f", got)
+	}
+	if got := revived.Snapshot().Beliefs[BeliefTrust].Strength; math.Abs(got-0.4) > 1e-9 {
+		t.Errorf("la confianza del grupo debía recalcularse al cargar: %.2f", got)
+	}
+}
@@ -0,0 +1,162 @@
+package psyche
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// testBody arma un enjambre rápido, tejido en malla y latiendo.
+// No arranca StartConsciousness: el biofeedback y el kill switch tocan el host real.
+func testBody(t *testing.T, size int) (*Cortex, *soma.Mesh) {
+	t.Helper()
+
+	pain := make(chan float64, 100)
+	reports := make(chan soma.TaskReport, 100)
+	events := soma.NewEventBus()
+
+	phys := soma.DefaultPhysiology()
+	phys.BaseLatency = soma.Duration(time.Millisecond)
+	phys.LatencyPerStress = soma.Duration(10 * time.Microsecond)
+	phys.BreatherDuration = soma.Duration(20 * time.Millisecond)
+
+	spawn := func(spec soma.NodeSpec) *soma.Node {
+		n := soma.NewNode(spec, phys, pain, reports)
+		n.Events = events
+		n.Start()
+		return n
+	}
+
+	nodes := make([]*soma.Node, size)
+	for i := range nodes {
+		nodes[i] = spawn(soma.NodeSpec{ID: fmt.Sprintf("N-%d", i+1)})
+	}
+	mesh, err := soma.NewMesh(nodes, soma.TopologyFull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mesh.Start()
+
+	c := NewCortex(nodes, pain, reports)
+	c.Events = events
+
+	policy := DefaultScalingPolicy()
+	policy.MinNodes, policy.MaxNodes = size, size*2
+	policy.ScaleUpLoad, policy.ScaleDownLoad = 0.2, 0.1
+	policy.Sustain, policy.Cooldown = 0, 0
+	c.Autoscaler = &Autoscaler{
+		Policy: policy,
+		Spawn: func(spec soma.NodeSpec) *soma.Node {
+			n := spawn(spec)
+			mesh.Add(n)
+			return n
+		},
+		Prune: func(n *soma.Node) { mesh.Remove(n.ID) },
+	}
+
+	// El dolor se descarta: aquí solo importa que nadie se bloquee
+	go func() {
+		for range pain {
+		}
+	}()
+	go c.listenReports()
+	go c.runQueue()
+	go c.watchTransitions(events.Subscribe(50))
+
+	return c, mesh
+}
+
+// TestConcurrentLoad martilla la mente desde varios frentes a la vez.
+// Su valor está en correr con -race: go test -race ./internal/psyche
+func TestConcurrentLoad(t *testing.T) {
+	c, mesh := testBody(t, 4)
+	brain := filepath.Join(t.TempDir(), "brain_dump.json")
+
+	var (
+		wg       sync.WaitGroup
+		accepted atomic.Int64
+		stop     = make(chan struct{})
+	)
+	hammer := func(f func(i int)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				f(i)
+			}
+		}()
+	}
+
+	for p := 0; p < 4; p++ {
+		source := fmt.Sprintf("productor-%d", p)
+		hammer(func(i int) {
+			v := c.Submit(TaskRequest{
+				Name:       fmt.Sprintf("tarea_%d", i%5),
+				Complexity: float64(i%9+1) / 10.0,
+				Priority:   Priority(i%3 - 1),
+				Source:     source,
+			})
+			if v.Accepted {
+				accepted.Add(1)
+			}
+			time.Sleep(time.Millisecond)
+		})
+	}
+	hammer(func(int) {
+		snap := c.Snapshot()
+		for _, n := range snap.Nodes {
+			if n.Integrity > n.MaxIntegrity {
+				t.Errorf("nodo %s con integridad sobre su techo", n.ID)
+			}
+		}
+	})
+	hammer(func(int) {
+		if err := c.SaveBrain(brain); err != nil {
+			t.Error(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	})
+	hammer(func(i int) {
+		mode := soma.CouplingContagion
+		if i%2 == 0 {
+			mode = soma.CouplingDampening
+		}
+		mesh.SetCoupling(soma.Coupling{Mode: mode, Strength: 0.3})
+		time.Sleep(2 * time.Millisecond)
+	})
+	hammer(func(i int) {
+		c.SetScheduler(SchedulerNames()[i%len(SchedulerNames())])
+		time.Sleep(2 * time.Millisecond)
+	})
+	hammer(func(int) {
+		// El metabolismo, pero más rápido que su pulso real
+		c.mu.Lock()
+		for _, n := range c.Body {
+			n.Regenerate(10 * time.Millisecond)
+		}
+		c.regulateGrowth(time.Now())
+		c.mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	})
+
+	time.Sleep(1500 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+
+	if accepted.Load() == 0 {
+		t.Fatal("ninguna orden fue admitida")
+	}
+	if err := c.LoadBrain(brain); err != nil {
+		t.Fatalf("el cerebro guardado bajo carga no se pudo leer: %v", err)
+	}
+}
@@ -2,6 +2,11 @@ package psyche
 
 import (
 	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
@@ -15,58 +20,475 @@ type Engram struct {
 	Trauma           bool      `json:"trauma"`
 	ReiterationCount int       `json:"reiteration_count"`
 	ForgivenessRate  float64   `json:"forgiveness_rate"`
+	Repetitions      int       `json:"repetitions"`        // Repasos espaciados (refuerzan la curva de Ebbinghaus)
+	Exposures        int       `json:"exposures"`          // Veces que se vivió, con o sin dolor
+	Episodes         []Episode `json:"episodes,omitempty"` // En qué circunstancias se vivió y cuánto dolió
+}
+
+// Class es la clase del engrama para elegir su curva de olvido: "trauma" o "neutral".
+func (e *Engram) Class() string {
+	if e.Trauma {
+		return "trauma"
+	}
+	return "neutral"
+}
+
+// MemoryPolicy dice cómo olvida y cómo generaliza el hipocampo.
+type MemoryPolicy struct {
+	Decay      map[string]string `json:"decay"`      // Clase de engrama -> curva de olvido
+	Aliases    map[string]string `json:"aliases"`    // Estímulo -> recuerdo al que equivale
+	Similarity float64           `json:"similarity"` // Parecido mínimo para generalizar el miedo (0 a 1)
+	Capacity   int               `json:"capacity"`   // Recuerdos de corto plazo; al pasarse se olvida el menos saliente
+}
+
+// DefaultMemoryPolicy olvida todo con la curva hiperbólica original,
+// generaliza a estímulos al menos 75% parecidos y guarda hasta 100 recuerdos recientes.
+func DefaultMemoryPolicy() MemoryPolicy {
+	return MemoryPolicy{
+		Decay:      map[string]string{"trauma": "hyperbolic", "neutral": "hyperbolic"},
+		Aliases:    make(map[string]string),
+		Similarity: 0.75,
+		Capacity:   100,
+	}
+}
+
+// Validate rechaza curvas o clases desconocidas y umbrales fuera de rango.
+func (p MemoryPolicy) Validate() error {
+	for class, name := range p.Decay {
+		if class != "trauma" && class != "neutral" {
+			return fmt.Errorf("clase de recuerdo desconocida: '%s' (opciones: trauma, neutral)", class)
+		}
+		if _, err := NewDecayModel(name); err != nil {
+			return err
+		}
+	}
+	if p.Similarity <= 0 || p.Similarity > 1 {
+		return fmt.Errorf("similarity debe estar entre 0 y 1")
+	}
+	if p.Capacity <= 0 {
+		return fmt.Errorf("capacity debe ser positivo")
+	}
+	return nil
 }
 
 type Hippocampus struct {
 	ShortTermMemory map[string]*Engram `json:"memories"`
+	LongTermMemory  map[string]*Engram `json:"long_term"` // Lo que el sueño consolidó: se olvida más despacio
+	Policy          MemoryPolicy       `json:"-"`         // Viene de la configuración, no del cerebro guardado
+	metrics         MemoryMetrics      // Lo que se olvidó en esta vida y por qué
 	mu              sync.RWMutex
 }
 
 func NewHippocampus() *Hippocampus {
 	return &Hippocampus{
 		ShortTermMemory: make(map[string]*Engram),
+		LongTermMemory:  make(map[string]*Engram),
+		Policy:          DefaultMemoryPolicy(),
 	}
 }
 
-// Remember busca si algo nos dolió antes.
-// Retorna el nivel de miedo anticipado (0.0 a 1.0)
-func (h *Hippocampus) ConsultarTrauma(trigger string) (float64, string) {
+// Snapshot copia la memoria bajo lock (para persistirla sin carreras).
+func (h *Hippocampus) Snapshot() *Hippocampus {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	engram, exists := h.ShortTermMemory[trigger]
-	if !exists {
+	out := NewHippocampus()
+	out.Policy = h.Policy
+	for key, e := range h.ShortTermMemory {
+		cp := *e
+		out.ShortTermMemory[key] = &cp
+	}
+	for key, e := range h.LongTermMemory {
+		cp := *e
+		out.LongTermMemory[key] = &cp
+	}
+	return out
+}
+
+const (
+	spacingGap       = 10 * time.Minute // Separación mínima para que una exposición cuente como repaso
+	longTermSlowdown = 10.0             // La memoria de largo plazo olvida diez veces más despacio
+)
+
+// canonical normaliza un estímulo y resuelve sus alias (primero el estímulo
+// completo, luego solo el nombre).
+func (h *Hippocampus) canonical(stimulus string) (string, []string) {
+	name, args := splitStimulus(stimulus)
+	if alias, ok := h.Policy.Aliases[strings.Join(append([]string{name}, args...), " ")]; ok {
+		return splitStimulus(alias)
+	}
+	if alias, ok := h.Policy.Aliases[name]; ok {
+		aliasName, aliasArgs := splitStimulus(alias)
+		return aliasName, append(aliasArgs, args...)
+	}
+	return name, args
+}
+
+// decay retorna la curva de olvido de un engrama según su clase.
+func (h *Hippocampus) decay(e *Engram) DecayModel {
+	if model, err := NewDecayModel(h.Policy.Decay[e.Class()]); err == nil {
+		return model
+	}
+	return Hyperbolic{Rate: 0.1} // El olvido original de Doloris
+}
+
+// canonicalKey es la llave bajo la que se guarda un estímulo.
+func (h *Hippocampus) canonicalKey(stimulus string) string {
+	name, args := h.canonical(stimulus)
+	return strings.Join(append([]string{name}, args...), " ")
+}
+
+// fade es el dolor que queda hoy de un recuerdo.
+func (h *Hippocampus) fade(e *Engram, now time.Time, longTerm bool) float64 {
+	elapsed := now.Sub(e.LastSeen)
+	if longTerm {
+		elapsed = time.Duration(float64(elapsed) / longTermSlowdown)
+	}
+	return h.decay(e).Fade(e, elapsed)
+}
+
+// each recorre ambos almacenes, primero el de corto plazo.
+func (h *Hippocampus) each(visit func(key string, e *Engram, longTerm bool)) {
+	for key, e := range h.ShortTermMemory {
+		visit(key, e, false)
+	}
+	for key, e := range h.LongTermMemory {
+		visit(key, e, true)
+	}
+}
+
+// Remember busca si algo nos dolió antes, o algo parecido.
+// Retorna el miedo anticipado (0 a 100), ponderado por el parecido con el recuerdo.
+func (h *Hippocampus) ConsultarTrauma(stimulus string) (float64, string) {
+	return h.ConsultarTraumaEn(stimulus, nil)
+}
+
+// ConsultarTraumaEn es ConsultarTrauma en unas circunstancias concretas: lo que
+// solo dolió bajo carga pesa poco cuando el sistema está en calma.
+func (h *Hippocampus) ConsultarTraumaEn(stimulus string, current *Situation) (float64, string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	now := time.Now()
+	name, args := h.canonical(stimulus)
+	if name == "" {
 		return 0.0, "Estímulo Nuevo (Curiosidad)"
 	}
 
-	// La memoria se desvanece con el tiempo (Olvido natural)
-	// Si pasó hace mucho, el miedo baja.
-	hoursSince := time.Since(engram.LastSeen).Hours()
-	fadedPain := engram.PainLevel / (1.0 + (hoursSince * 0.1))
+	// Generalización: el miedo se contagia a lo que se parece
+	var (
+		source  *Engram
+		key     string
+		bestSim float64
+		fear    float64
+	)
+	h.each(func(k string, e *Engram, longTerm bool) {
+		kName, kArgs := splitStimulus(k)
+		sim := similarity(name, args, kName, kArgs)
+		if sim < h.Policy.Similarity {
+			return
+		}
 
-	if engram.Trauma {
-		return fadedPain, fmt.Sprintf("⚠️ ALERTA: Recuerdo traumático detectado. Dolor previo: %.1f", engram.PainLevel)
+		felt := h.fade(e, now, longTerm) * sim
+		if current != nil {
+			weight, _ := e.contextWeight(*current)
+			felt *= weight
+		}
+		if source == nil || felt > fear || (felt == fear && k < key) {
+			source, key, bestSim, fear = e, k, sim, felt
+		}
+	})
+	if source == nil {
+		return 0.0, "Estímulo Nuevo (Curiosidad)"
 	}
 
-	return fadedPain, "Recuerdo neutral accesible."
+	origin := ""
+	if key != strings.ToLower(strings.Join(strings.Fields(stimulus), " ")) {
+		origin = fmt.Sprintf(" Viene de '%s' (%.0f%% parecido).", key, bestSim*100)
+	}
+	if current != nil {
+		if weight, worst := source.contextWeight(*current); worst != nil && weight < 1 {
+			origin += fmt.Sprintf(" Ahora pesa %.0f%%: dolió con %s.", weight*100, worst.Situation)
+		}
+	}
+
+	if source.Trauma {
+		return fear, fmt.Sprintf("⚠️ ALERTA: Recuerdo traumático detectado. Dolor previo: %.1f (%d veces).%s", source.PainLevel, source.ReiterationCount, origin)
+	}
+	return fear, "Recuerdo neutral accesible." + origin
+}
+
+// Recollection es un recuerdo tal como se siente ahora.
+type Recollection struct {
+	Trigger      string
+	PainLevel    float64 // Dolor consolidado
+	Faded        float64 // Lo que queda tras la curva de olvido
+	Model        string
+	Trauma       bool
+	Reiterations int
+	Repetitions  int
+	Exposures    int
+	Forgiveness  float64 // Ritmo al que una exposición inofensiva borra el miedo
+	Salience     float64 // Cuánto le cuesta olvidarlo si falta espacio
+	Episodes     []Episode
+	LastSeen     time.Time
+	LongTerm     bool // Ya consolidado por el sueño
+}
+
+// recollect describe un recuerdo tal como se siente en now. Requiere h.mu tomado.
+func (h *Hippocampus) recollect(key string, e *Engram, longTerm bool, now time.Time) Recollection {
+	return Recollection{
+		Trigger:      key,
+		PainLevel:    e.PainLevel,
+		Faded:        h.fade(e, now, longTerm),
+		Model:        h.decay(e).Name(),
+		Trauma:       e.Trauma,
+		Reiterations: e.ReiterationCount,
+		Repetitions:  e.Repetitions,
+		Exposures:    e.Exposures,
+		Forgiveness:  e.forgiveness(),
+		Salience:     h.salience(e, now),
+		Episodes:     append([]Episode(nil), e.Episodes...),
+		LastSeen:     e.LastSeen,
+		LongTerm:     longTerm,
+	}
+}
+
+// Recall lista los recuerdos con su dolor actual, del más vivo al más borroso.
+func (h *Hippocampus) Recall() []Recollection {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	now := time.Now()
+	out := make([]Recollection, 0, len(h.ShortTermMemory)+len(h.LongTermMemory))
+	h.each(func(key string, e *Engram, longTerm bool) {
+		out = append(out, h.recollect(key, e, longTerm, now))
+	})
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Faded != out[j].Faded {
+			return out[i].Faded > out[j].Faded
+		}
+		return out[i].Trigger < out[j].Trigger
+	})
+	return out
+}
+
+const (
+	traumaThreshold    = 40.0 // Dolor desde el cual una experiencia es traumática
+	reinforcementGain  = 5.0  // Dolor extra por cada vez que la herida se reabre
+	defaultForgiveness = 0.25 // Fracción del miedo que borra una exposición inofensiva
+	scarringFactor     = 0.8  // Cada reiteración vuelve más lento el perdón
+)
+
+// forgiveness es el ritmo de perdón del engrama (los recuerdos viejos no lo traían).
+func (e *Engram) forgiveness() float64 {
+	if e.ForgivenessRate <= 0 {
+		return defaultForgiveness
+	}
+	return e.ForgivenessRate
 }
 
 // Store guarda una nueva experiencia.
 // Aquí es donde la IA aprende a tener miedo de ciertas tareas.
+// No sobrescribe: lo que vuelve a doler refuerza el trauma y lo que ya no
+// duele lo va extinguiendo, al ritmo de perdón de cada recuerdo.
 func (h *Hippocampus) ConsolidarRecuerdo(trigger string, painExperienced float64) {
+	h.ConsolidarEpisodio(trigger, painExperienced, nil)
+}
+
+// ConsolidarEpisodio es ConsolidarRecuerdo con las circunstancias en que se vivió.
+// El perdón depende del contexto: lo inofensivo en calma apenas borra lo que dolió bajo carga.
+func (h *Hippocampus) ConsolidarEpisodio(trigger string, painExperienced float64, situation *Situation) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	isTrauma := painExperienced > 40.0 // Umbral de trauma
+	// Lo vivido bajo un alias se recuerda bajo su nombre canónico
+	trigger = h.canonicalKey(trigger)
+
+	// Revivir un recuerdo consolidado lo modifica ahí mismo (reconsolidación)
+	now := time.Now()
+	engram, exists := h.ShortTermMemory[trigger]
+	if !exists {
+		engram, exists = h.LongTermMemory[trigger]
+	}
+	if !exists {
+		engram = &Engram{Trigger: trigger, ForgivenessRate: defaultForgiveness}
+		h.ShortTermMemory[trigger] = engram
+		defer h.evict(trigger, now) // Con el recuerdo nuevo ya formado
+	} else if now.Sub(engram.LastSeen) >= spacingGap {
+		engram.Repetitions++
+	}
+	engram.LastSeen = now
+	engram.Exposures++
+
+	forgiveness := engram.forgiveness()
+	if situation != nil {
+		if weight, worst := engram.contextWeight(*situation); worst != nil {
+			forgiveness *= weight
+		}
+		engram.remember(Episode{Situation: *situation, Pain: painExperienced})
+	}
+
+	if painExperienced > traumaThreshold {
+		// REFUERZO: la herida se reabre y cala más hondo
+		deepened := math.Max(engram.PainLevel, painExperienced) + reinforcementGain*float64(engram.ReiterationCount)
+		engram.PainLevel = math.Min(100.0, deepened)
+		engram.ReiterationCount++
+		if engram.ReiterationCount > 1 {
+			engram.ForgivenessRate = engram.forgiveness() * scarringFactor
+		}
+		engram.Trauma = true
 
-	h.ShortTermMemory[trigger] = &Engram{
-		Trigger:   trigger,
-		PainLevel: painExperienced,
-		LastSeen:  time.Now(),
-		Trauma:    isTrauma,
+		fmt.Printf("🧠 [HIPOCAMPO] Trauma consolidado: '%s' causó %.1f de dolor (reiteración %d).\n", trigger, painExperienced, engram.ReiterationCount)
+		return
 	}
 
-	if isTrauma {
-		fmt.Printf("🧠 [HIPOCAMPO] Trauma consolidado: '%s' causó %.1f de dolor.\n", trigger, painExperienced)
+	if painExperienced >= engram.PainLevel {
+		engram.PainLevel = painExperienced
+		return
 	}
+
+	// PERDÓN: una exposición que dolió menos de lo temido extingue parte del miedo
+	engram.PainLevel -= (engram.PainLevel - painExperienced) * forgiveness
+	if engram.Trauma && engram.PainLevel <= traumaThreshold {
+		engram.Trauma = false
+		fmt.Printf("🕊️ [HIPOCAMPO] '%s' ya no es un trauma: el miedo se extinguió (%.1f).\n", trigger, engram.PainLevel)
+	}
+}
+
+// Recall lista los recuerdos del Cortex con su dolor actual.
+func (c *Cortex) Recall() []Recollection {
+	c.mu.Lock()
+	memory := c.Memory
+	c.mu.Unlock()
+
+	return memory.Recall()
+}
+
+// ConsolidationReport es lo que hizo una noche de sueño con la memoria.
+type ConsolidationReport struct {
+	Promoted int // Pasaron a largo plazo
+	Merged   int // Se fundieron con un recuerdo igual
+	Pruned   int // Eran tan tenues que se olvidaron
+}
+
+func (r ConsolidationReport) String() string {
+	return fmt.Sprintf("%d consolidados, %d fusionados, %d olvidados", r.Promoted, r.Merged, r.Pruned)
+}
+
+// Consolidate es el trabajo del sueño: funde duplicados, pasa a largo plazo
+// lo repetido o intenso y olvida lo trivial.
+func (h *Hippocampus) Consolidate(policy SleepPolicy, now time.Time) ConsolidationReport {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var report ConsolidationReport
+
+	// 1. Duplicados: el mismo estímulo guardado con otra forma (mayúsculas, alias nuevos)
+	for _, key := range slices.Sorted(maps.Keys(h.ShortTermMemory)) {
+		canon := h.canonicalKey(key)
+		if canon == key {
+			continue
+		}
+		e := h.ShortTermMemory[key]
+		delete(h.ShortTermMemory, key)
+		if other, ok := h.ShortTermMemory[canon]; ok {
+			mergeEngram(other, e)
+			report.Merged++
+			continue
+		}
+		e.Trigger = canon
+		h.ShortTermMemory[canon] = e
+	}
+
+	// 2. Lo que importa pasa a largo plazo; lo trivial se olvida
+	for key, e := range h.ShortTermMemory {
+		repeated := e.ReiterationCount >= policy.PromoteReiterations || e.Repetitions >= policy.PromoteReiterations
+		switch {
+		case repeated || e.PainLevel >= policy.PromotePain:
+			delete(h.ShortTermMemory, key)
+			if lt, ok := h.LongTermMemory[key]; ok {
+				mergeEngram(lt, e)
+				report.Merged++
+			} else {
+				h.LongTermMemory[key] = e
+				report.Promoted++
+			}
+		case h.fade(e, now, false) < policy.PruneBelow:
+			h.forget(key, e, now, "pruned", fmt.Sprintf("demasiado tenue al dormir (%.2f < %.2f)", h.fade(e, now, false), policy.PruneBelow))
+			report.Pruned++
+		}
+	}
+	return report
+}
+
+// mergeEngram funde src en dst: queda lo peor de ambos y la historia de los dos.
+func mergeEngram(dst, src *Engram) {
+	dst.PainLevel = math.Max(dst.PainLevel, src.PainLevel)
+	dst.Trauma = dst.Trauma || src.Trauma
+	dst.ReiterationCount += src.ReiterationCount
+	dst.Repetitions += src.Repetitions
+	dst.Exposures += src.Exposures
+	dst.Episodes = append(dst.Episodes, src.Episodes...)
+	sort.SliceStable(dst.Episodes, func(i, j int) bool { return dst.Episodes[i].At.Before(dst.Episodes[j].At) })
+	if len(dst.Episodes) > maxEpisodes {
+		dst.Episodes = dst.Episodes[len(dst.Episodes)-maxEpisodes:]
+	}
+	dst.ForgivenessRate = math.Min(dst.forgiveness(), src.forgiveness())
+	if src.LastSeen.After(dst.LastSeen) {
+		dst.LastSeen = src.LastSeen
+	}
+}
+
+// Lookup describe el recuerdo guardado bajo un estímulo (o su alias).
+func (h *Hippocampus) Lookup(stimulus string) (Recollection, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	key := h.canonicalKey(stimulus)
+	if e, ok := h.ShortTermMemory[key]; ok {
+		return h.recollect(key, e, false, time.Now()), true
+	}
+	if e, ok := h.LongTermMemory[key]; ok {
+		return h.recollect(key, e, true, time.Now()), true
+	}
+	return Recollection{}, false
+}
+
+// searchSimilarity es el parecido mínimo para que una búsqueda encuentre un recuerdo sin contenerlo.
+const searchSimilarity = 0.5
+
+// Search busca recuerdos que contengan el texto o se le parezcan.
+func (h *Hippocampus) Search(query string) []Recollection {
+	name, args := splitStimulus(query)
+	needle := strings.ToLower(strings.TrimSpace(query))
+
+	var found []Recollection
+	for _, r := range h.Recall() {
+		kName, kArgs := splitStimulus(r.Trigger)
+		if strings.Contains(r.Trigger, needle) || similarity(name, args, kName, kArgs) >= searchSimilarity {
+			found = append(found, r)
+		}
+	}
+	return found
+}
+
+// Delete borra un recuerdo de cualquiera de los dos almacenes y retorna cómo era.
+func (h *Hippocampus) Delete(stimulus, reason string) (Recollection, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	key := h.canonicalKey(stimulus)
+	e, longTerm := h.ShortTermMemory[key], false
+	if e == nil {
+		e, longTerm = h.LongTermMemory[key], true
+	}
+	if e == nil {
+		return Recollection{}, false
+	}
+
+	was := h.recollect(key, e, longTerm, now)
+	h.forget(key, e, now, "deleted", reason)
+	return was, true
 }
@@ -0,0 +1,142 @@
+package psyche
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRepeatedPainDeepensTrauma(t *testing.T) {
+	h := NewHippocampus()
+
+	h.ConsolidarRecuerdo("leer", 50.0)
+	first, _ := h.ConsultarTrauma("leer")
+	h.ConsolidarRecuerdo("leer", 50.0)
+	second, _ := h.ConsultarTrauma("leer")
+
+	if second <= first {
+		t.Fatalf("la reiteración no profundizó el miedo: %.1f -> %.1f", first, second)
+	}
+	e := h.ShortTermMemory["leer"]
+	if e.ReiterationCount != 2 || e.ForgivenessRate >= defaultForgiveness {
+		t.Fatalf("engrama sin reforzar: %+v", e)
+	}
+}
+
+func TestHarmlessExposuresExtinguishFear(t *testing.T) {
+	h := NewHippocampus()
+	h.ConsolidarRecuerdo("leer", 80.0)
+
+	before, _ := h.ConsultarTrauma("leer")
+	for i := 0; i < 10; i++ {
+		h.ConsolidarRecuerdo("leer", 0.0)
+	}
+	after, _ := h.ConsultarTrauma("leer")
+
+	if after >= before/2 {
+		t.Fatalf("el perdón no extinguió el miedo: %.1f -> %.1f", before, after)
+	}
+	if h.ShortTermMemory["leer"].Trauma {
+		t.Fatal("el trauma debió extinguirse")
+	}
+}
+
+func TestDeepWoundsForgiveSlower(t *testing.T) {
+	h := NewHippocampus()
+	h.ConsolidarRecuerdo("una-vez", 80.0)
+	for i := 0; i < 4; i++ {
+		h.ConsolidarRecuerdo("muchas-veces", 80.0)
+	}
+	h.ShortTermMemory["muchas-veces"].PainLevel = 80.0 // Mismo punto de partida
+
+	for i := 0; i < 3; i++ {
+		h.ConsolidarRecuerdo("una-vez", 0.0)
+		h.ConsolidarRecuerdo("muchas-veces", 0.0)
+	}
+
+	once, _ := h.ConsultarTrauma("una-vez")
+	often, _ := h.ConsultarTrauma("muchas-veces")
+	if often <= once {
+		t.Fatalf("la herida reiterada perdonó igual de rápido: %.1f vs %.1f", often, once)
+	}
+}
+
+func TestDecayModels(t *testing.T) {
+	e := &Engram{PainLevel: 80.0}
+	day := 24 * time.Hour
+
+	cases := map[string]float64{
+		"hyperbolic":  80.0 / (1.0 + 24*0.1),
+		"exponential": 40.0,
+		"ebbinghaus":  80.0 * math.Exp(-4),
+		"never":       80.0,
+	}
+	for name, want := range cases {
+		model, err := NewDecayModel(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := model.Fade(e, day); math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: tras un día se esperaba %.3f, queda %.3f", name, want, got)
+		}
+	}
+
+	// Los repasos espaciados estabilizan el recuerdo
+	ebbinghaus, _ := NewDecayModel("ebbinghaus")
+	studied := &Engram{PainLevel: 80.0, Repetitions: 3}
+	if ebbinghaus.Fade(studied, day) <= ebbinghaus.Fade(e, day) {
+		t.Error("el repaso espaciado no frenó el olvido")
+	}
+}
+
+func TestDecayIsChosenByClass(t *testing.T) {
+	h := NewHippocampus()
+	h.Policy.Decay = map[string]string{"trauma": "never", "neutral": "exponential"}
+	old := time.Now().Add(-48 * time.Hour)
+	h.ShortTermMemory["supernova"] = &Engram{Trigger: "supernova", PainLevel: 90.0, Trauma: true, LastSeen: old}
+	h.ShortTermMemory["leer"] = &Engram{Trigger: "leer", PainLevel: 20.0, LastSeen: old}
+
+	if fear, _ := h.ConsultarTrauma("supernova"); fear != 90.0 {
+		t.Errorf("un trauma que nunca se olvida se desvaneció: %.1f", fear)
+	}
+	if fear, _ := h.ConsultarTrauma("leer"); math.Abs(fear-5.0) > 0.01 {
+		t.Errorf("dos vidas medias debían dejar 5.0, quedan %.2f", fear)
+	}
+}
+
+func TestFearGeneralizesToSimilarStimuli(t *testing.T) {
+	h := NewHippocampus()
+	h.Policy.Decay = map[string]string{"trauma": "never"}
+	h.Policy.Aliases = map[string]string{"big_bang": "supernova"}
+	h.ConsolidarRecuerdo("supernova", 90.0)
+
+	for _, stimulus := range []string{"supernova2", "Supernova --force", "big_bang"} {
+		fear, log := h.ConsultarTrauma(stimulus)
+		if fear <= 60.0 || fear > 90.0 {
+			t.Errorf("'%s': miedo %.1f, se esperaba el trauma ponderado por parecido", stimulus, fear)
+		}
+		if !strings.Contains(log, "'supernova'") {
+			t.Errorf("'%s': la respuesta no nombra el recuerdo de origen: %s", stimulus, log)
+		}
+	}
+
+	exact, _ := h.ConsultarTrauma("supernova")
+	similar, _ := h.ConsultarTrauma("supernova2")
+	if similar >= exact {
+		t.Errorf("el parecido debía pesar menos que el original: %.1f vs %.1f", similar, exact)
+	}
+	if fear, _ := h.ConsultarTrauma("leer"); fear != 0.0 {
+		t.Errorf("un estímulo distinto heredó miedo: %.1f", fear)
+	}
+}
+
+func TestAliasedExperienceIsStoredCanonically(t *testing.T) {
+	h := NewHippocampus()
+	h.Policy.Aliases = map[string]string{"big_bang": "supernova"}
+	h.ConsolidarRecuerdo("big_bang", 90.0)
+
+	if _, ok := h.ShortTermMemory["supernova"]; !ok {
+		t.Fatal("lo vivido bajo un alias debía recordarse bajo su nombre canónico")
+	}
+}
@@ -0,0 +1,277 @@
+package psyche
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// maxMoodHistory es cuántos cambios de ánimo recuerda el Cortex.
+const maxMoodHistory = 100
+
+// Mood es el estado afectivo de Doloris, montado sobre el dolor percibido.
+type Mood string
+
+const (
+	MoodCalm       Mood = "calm"
+	MoodUneasy     Mood = "uneasy"
+	MoodAnxious    Mood = "anxious"
+	MoodPanic      Mood = "panic"
+	MoodAgony      Mood = "agony"
+	MoodExhausted  Mood = "exhausted"  // Tras una crisis larga: sin fuerzas, solo urgencias
+	MoodRecovering Mood = "recovering" // Tras una crisis: vuelve despacio a la calma
+)
+
+// moodLadder son los estados a los que se sube por dolor, de menor a mayor.
+var moodLadder = []Mood{MoodCalm, MoodUneasy, MoodAnxious, MoodPanic, MoodAgony}
+
+// MoodNames lista los estados afectivos.
+func MoodNames() []string {
+	return []string{string(MoodCalm), string(MoodUneasy), string(MoodAnxious), string(MoodPanic), string(MoodAgony), string(MoodExhausted), string(MoodRecovering)}
+}
+
+func (m Mood) String() string {
+	switch m {
+	case MoodCalm:
+		return "Calma"
+	case MoodUneasy:
+		return "Inquietud"
+	case MoodAnxious:
+		return "Ansiedad"
+	case MoodPanic:
+		return "Pánico"
+	case MoodAgony:
+		return "Agonía"
+	case MoodExhausted:
+		return "Agotamiento"
+	case MoodRecovering:
+		return "Recuperación"
+	}
+	return string(m)
+}
+
+// severity ordena los estados: solo se escala hacia uno más grave.
+func (m Mood) severity() int {
+	switch m {
+	case MoodUneasy, MoodRecovering:
+		return 1
+	case MoodAnxious, MoodExhausted:
+		return 2
+	case MoodPanic:
+		return 3
+	case MoodAgony:
+		return 4
+	}
+	return 0
+}
+
+// crisis dice si el estado es pánico o agonía (el viejo IsPanic).
+func (m Mood) crisis() bool {
+	return m == MoodPanic || m == MoodAgony
+}
+
+// MoodProfile es cómo sube y baja un estado y cómo se comporta Doloris en él.
+type MoodProfile struct {
+	Enter float64       `json:"enter"` // Se sube a este estado cuando el dolor lo supera (solo la escalera de dolor)
+	Exit  float64       `json:"exit"`  // Se baja cuando el dolor cae por debajo (histéresis)
+	Dwell soma.Duration `json:"dwell"` // Tiempo mínimo en el estado antes de bajar (subir es inmediato)
+
+	Refuse     bool    `json:"refuse,omitempty"`      // Rechaza (o aparca) toda orden
+	UrgentOnly bool    `json:"urgent_only,omitempty"` // Solo admite prioridad alta
+	Hold       bool    `json:"hold,omitempty"`        // Lo admitido espera en la cola
+	FearLimit  float64 `json:"fear_limit"`            // Miedo sobre el cual se niega a una tarea
+	Scheduler  string  `json:"scheduler,omitempty"`   // Estrategia de reparto propia ("" = la configurada)
+	Apology    float64 `json:"apology"`               // Cuánto pesa una disculpa (0 = no las acepta)
+	KillSwitch bool    `json:"kill_switch,omitempty"` // La corteza motora caza al proceso que la hiere
+}
+
+// MoodPolicy define cada estado afectivo.
+type MoodPolicy struct {
+	States       map[Mood]MoodProfile `json:"states"`
+	ExhaustAfter soma.Duration        `json:"exhaust_after"` // Una crisis más larga que esto termina en agotamiento
+}
+
+// DefaultMoodPolicy reproduce los umbrales de siempre: pánico sobre 80 hasta bajar de 50,
+// kill switch sobre 95, y miedo tolerado hasta 60 en calma.
+func DefaultMoodPolicy() MoodPolicy {
+	return MoodPolicy{
+		States: map[Mood]MoodProfile{
+			MoodCalm:       {FearLimit: 60, Apology: 1.0},
+			MoodUneasy:     {Enter: 20, Exit: 10, Dwell: soma.Duration(3 * time.Second), FearLimit: 60, Apology: 1.0},
+			MoodAnxious:    {Enter: 50, Exit: 35, Dwell: soma.Duration(5 * time.Second), FearLimit: 45, Scheduler: "fear-aware", Apology: 0.5},
+			MoodPanic:      {Enter: 80, Exit: 50, Dwell: soma.Duration(5 * time.Second), Refuse: true, Hold: true, FearLimit: 0},
+			MoodAgony:      {Enter: 95, Exit: 85, Dwell: soma.Duration(3 * time.Second), Refuse: true, Hold: true, FearLimit: 0, KillSwitch: true},
+			MoodExhausted:  {Dwell: soma.Duration(20 * time.Second), UrgentOnly: true, FearLimit: 30, Scheduler: "integrity", Apology: 0.5},
+			MoodRecovering: {Exit: 10, Dwell: soma.Duration(10 * time.Second), FearLimit: 50, Scheduler: "integrity", Apology: 1.5},
+		},
+		ExhaustAfter: soma.Duration(30 * time.Second),
+	}
+}
+
+// Validate exige los siete estados, una escalera de dolor creciente y estrategias que existan.
+func (p MoodPolicy) Validate() error {
+	for _, name := range MoodNames() {
+		if _, ok := p.States[Mood(name)]; !ok {
+			return fmt.Errorf("falta el estado '%s'", name)
+		}
+	}
+	for m, s := range p.States {
+		if !slices.Contains(MoodNames(), string(m)) {
+			return fmt.Errorf("estado desconocido '%s' (opciones: %s)", m, strings.Join(MoodNames(), ", "))
+		}
+		if s.Dwell < 0 || s.Apology < 0 || s.FearLimit < 0 || s.FearLimit > 100 {
+			return fmt.Errorf("estado '%s': dwell y apology no pueden ser negativos y fear_limit va de 0 a 100", m)
+		}
+		if s.Scheduler != "" {
+			if _, err := NewScheduler(s.Scheduler); err != nil {
+				return fmt.Errorf("estado '%s': %v", m, err)
+			}
+		}
+	}
+	for i, m := range moodLadder[1:] {
+		s, below := p.States[m], p.States[moodLadder[i]]
+		if s.Exit >= s.Enter || s.Enter <= below.Enter || s.Enter > 100 {
+			return fmt.Errorf("estado '%s': enter debe superar al de '%s' y a su propio exit", m, moodLadder[i])
+		}
+	}
+	if p.ExhaustAfter < 0 {
+		return fmt.Errorf("exhaust_after no puede ser negativo")
+	}
+	return nil
+}
+
+// next decide el estado siguiente: se escala al instante hacia el estado que pide
+// el dolor; se baja un peldaño a la vez, solo tras el tiempo mínimo del estado
+// actual y con el dolor bajo su umbral de salida.
+func (p MoodPolicy) next(current Mood, pain float64, since, crisisSince, now time.Time) Mood {
+	target := MoodCalm
+	for _, m := range moodLadder[1:] {
+		if pain > p.States[m].Enter {
+			target = m
+		}
+	}
+	if target.severity() > current.severity() {
+		return target
+	}
+
+	profile := p.States[current]
+	if now.Sub(since) < time.Duration(profile.Dwell) {
+		return current
+	}
+
+	switch current {
+	case MoodAgony:
+		if pain < profile.Exit {
+			return MoodPanic
+		}
+	case MoodPanic:
+		if pain < profile.Exit {
+			if now.Sub(crisisSince) >= time.Duration(p.ExhaustAfter) {
+				return MoodExhausted
+			}
+			return MoodRecovering
+		}
+	case MoodExhausted:
+		return MoodRecovering
+	case MoodRecovering, MoodUneasy:
+		if pain < profile.Exit {
+			return MoodCalm
+		}
+	case MoodAnxious:
+		if pain < profile.Exit {
+			return MoodUneasy
+		}
+	}
+	return current
+}
+
+// MoodEvent es un cambio de estado afectivo.
+type MoodEvent struct {
+	At   time.Time `json:"at"`
+	From Mood      `json:"from"`
+	To   Mood      `json:"to"`
+	Pain float64   `json:"pain"`
+}
+
+func (e MoodEvent) String() string {
+	return fmt.Sprintf("%s 🎭 %s → %s (dolor %.0f%%)", e.At.Format("2006-01-02 15:04:05"), e.From, e.To, e.Pain)
+}
+
+// moodProfile es el comportamiento del estado actual. Requiere c.mu tomado.
+func (c *Cortex) moodProfile() MoodProfile {
+	return c.MoodPolicy.States[c.Mood]
+}
+
+// regulateMood deja que el dolor mueva el estado afectivo. Requiere c.mu tomado.
+func (c *Cortex) regulateMood(now time.Time) {
+	if next := c.MoodPolicy.next(c.Mood, c.CurrentPain, c.moodSince, c.crisisSince, now); next != c.Mood {
+		c.setMood(next, now)
+	}
+}
+
+// setMood cambia de estado, lo anota y reacciona a la entrada y salida de las crisis. Requiere c.mu tomado.
+func (c *Cortex) setMood(to Mood, now time.Time) {
+	from := c.Mood
+	if from == "" {
+		from = MoodCalm
+	}
+	c.Mood, c.moodSince = to, now
+	c.IsPanic = to.crisis()
+
+	c.Moods = append(c.Moods, MoodEvent{At: now, From: from, To: to, Pain: c.CurrentPain})
+	if len(c.Moods) > maxMoodHistory {
+		c.Moods = c.Moods[len(c.Moods)-maxMoodHistory:]
+	}
+
+	switch {
+	case to.crisis() && !from.crisis():
+		c.crisisSince = now
+		fmt.Println("🚨 [CORTEX] ¡PÁNICO SISTÉMICO! Bloqueando nuevas tareas.")
+		if c.IsAsleep {
+			c.wake("¡El dolor me despertó!")
+		}
+	case from.crisis() && !to.crisis():
+		fmt.Printf("\n🧘 [CORTEX] Niveles de dolor estables. Saliendo del estado de pánico (%s).\n", to)
+		c.reviewParked(now)
+	default:
+		fmt.Printf("\n🎭 [ÁNIMO] %s → %s (dolor %.0f%%)\nUSER@DOLORIS > ", from, to, c.CurrentPain)
+	}
+}
+
+// scheduler es la estrategia de reparto del estado actual. Requiere c.mu tomado.
+func (c *Cortex) scheduler() Scheduler {
+	name := c.moodProfile().Scheduler
+	if name == "" {
+		return c.Scheduler
+	}
+	if s, ok := c.moodSchedulers[name]; ok {
+		return s
+	}
+	s, err := NewScheduler(name)
+	if err != nil {
+		return c.Scheduler // Ya validado al cargar la configuración
+	}
+	if c.moodSchedulers == nil {
+		c.moodSchedulers = make(map[string]Scheduler)
+	}
+	c.moodSchedulers[name] = s
+	return s
+}
+
+// MoodHistory retorna los cambios de ánimo entre from y to (to cero = hasta ahora).
+func (c *Cortex) MoodHistory(from, to time.Time) []MoodEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var out []MoodEvent
+	for _, e := range c.Moods {
+		if e.At.Before(from) || (!to.IsZero() && e.At.After(to)) {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
@@ -0,0 +1,143 @@
+package psyche
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMoodEscalatesAtOnceAndCalmsDownSlowly(t *testing.T) {
+	p := DefaultMoodPolicy()
+	start := time.Now()
+
+	cases := []struct {
+		name    string
+		current Mood
+		pain    float64
+		since   time.Duration // Tiempo en el estado actual
+		want    Mood
+	}{
+		{"inquietud", MoodCalm, 25, 0, MoodUneasy},
+		{"salto directo al pánico", MoodCalm, 85, 0, MoodPanic},
+		{"agonía", MoodPanic, 96, 0, MoodAgony},
+		{"histéresis de la inquietud", MoodUneasy, 15, time.Minute, MoodUneasy},
+		{"vuelve a la calma", MoodUneasy, 5, time.Minute, MoodCalm},
+		{"tiempo mínimo de la ansiedad", MoodAnxious, 0, time.Second, MoodAnxious},
+		{"baja un peldaño a la vez", MoodAnxious, 0, time.Minute, MoodUneasy},
+		{"el pánico espera bajar de 50", MoodPanic, 60, time.Minute, MoodPanic},
+		{"crisis breve: recuperación", MoodPanic, 40, 10 * time.Second, MoodRecovering},
+		{"la agonía cede al pánico", MoodAgony, 80, time.Minute, MoodPanic},
+		{"el agotamiento termina en recuperación", MoodExhausted, 40, time.Minute, MoodRecovering},
+		{"recuperación sin recaída leve", MoodRecovering, 30, time.Minute, MoodRecovering},
+		{"recuperación con recaída fuerte", MoodRecovering, 55, 0, MoodAnxious},
+		{"recuperada", MoodRecovering, 5, time.Minute, MoodCalm},
+	}
+	for _, tc := range cases {
+		since := start.Add(-tc.since)
+		if got := p.next(tc.current, tc.pain, since, since, start); got != tc.want {
+			t.Errorf("%s: %s con dolor %.0f -> %s, se esperaba %s", tc.name, tc.current, tc.pain, got, tc.want)
+		}
+	}
+
+	// Una crisis larga agota
+	if got := p.next(MoodPanic, 40, start.Add(-time.Minute), start.Add(-time.Minute), start); got != MoodExhausted {
+		t.Errorf("una crisis de un minuto debía agotar, quedó en %s", got)
+	}
+}
+
+func TestMoodTransitionsAreRecorded(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	now := time.Now()
+
+	c.CurrentPain = 90.0
+	c.regulateMood(now)
+	if c.Mood != MoodPanic || !c.IsPanic {
+		t.Fatalf("dolor 90 debía ser pánico: %s", c.Mood)
+	}
+	c.CurrentPain = 10.0
+	c.regulateMood(now.Add(time.Second)) // Aún no cumple el tiempo mínimo
+	c.regulateMood(now.Add(10 * time.Second))
+	if c.Mood != MoodRecovering || c.IsPanic {
+		t.Fatalf("tras el pánico breve debía recuperarse: %s", c.Mood)
+	}
+
+	moods := c.MoodHistory(time.Time{}, time.Time{})
+	if len(moods) != 2 || moods[0].From != MoodCalm || moods[0].To != MoodPanic || moods[1].To != MoodRecovering || moods[0].Pain != 90.0 {
+		t.Errorf("transiciones mal anotadas: %v", moods)
+	}
+}
+
+func TestBehaviourIsDefinedPerMood(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.Memory.Policy.Decay = map[string]string{"trauma": "never", "neutral": "never"}
+	c.Memory.ConsolidarRecuerdo("render", 50.0) // Miedo 50: tolerable en calma, no en ansiedad
+
+	if v := c.Submit(TaskRequest{Name: "render"}); !v.Accepted {
+		t.Fatalf("en calma un miedo de 50 se tolera: %s", v.Message)
+	}
+
+	c.setMood(MoodAnxious, time.Now())
+	if v := c.Submit(TaskRequest{Name: "render"}); v.Accepted {
+		t.Fatal("ansiosa, un miedo de 50 debía rechazarse")
+	}
+	if got := c.scheduler().Name(); got != "fear-aware" {
+		t.Errorf("ansiosa debía repartir con fear-aware, reparte con %s", got)
+	}
+
+	c.setMood(MoodExhausted, time.Now())
+	v := c.Submit(TaskRequest{Name: "leer"})
+	if v.Accepted || !strings.Contains(v.Message, "urgencias") || v.RetryAfter <= 0 {
+		t.Fatalf("agotada solo atiende urgencias: %+v", v)
+	}
+	if v := c.Submit(TaskRequest{Name: "leer", Priority: PriorityHigh}); !v.Accepted {
+		t.Fatalf("agotada debía atender una urgencia: %s", v.Message)
+	}
+
+	c.setMood(MoodPanic, time.Now())
+	if ok, msg := c.Soothe(); ok || !strings.Contains(msg, "PÁNICO") {
+		t.Errorf("en pánico no se aceptan disculpas: %s", msg)
+	}
+	if c.moodProfile().KillSwitch {
+		t.Error("el kill switch es solo de la agonía")
+	}
+	c.setMood(MoodAgony, time.Now())
+	if !c.moodProfile().KillSwitch {
+		t.Error("en agonía la corteza motora debía defenderse")
+	}
+}
+
+func TestOldBrainInPanicWakesUpInPanic(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.CurrentPain = 90.0
+	c.regulateMood(time.Now())
+	c.Mood = "" // Un cerebro guardado antes del ánimo solo sabía esto
+	path := t.TempDir() + "/brain.json"
+	if err := c.SaveBrain(path); err != nil {
+		t.Fatal(err)
+	}
+
+	revived := stalledCortex(t, DefaultQueuePolicy())
+	if err := revived.LoadBrain(path); err != nil {
+		t.Fatal(err)
+	}
+	if revived.Mood != MoodPanic || !revived.IsPanic {
+		t.Errorf("el pánico guardado debía restaurarse como pánico: %s", revived.Mood)
+	}
+}
+
+func TestBadMoodPolicies(t *testing.T) {
+	cases := map[string]func(*MoodPolicy){
+		"falta un estado":        func(p *MoodPolicy) { delete(p.States, MoodExhausted) },
+		"escalera desordenada":   func(p *MoodPolicy) { s := p.States[MoodPanic]; s.Enter = 40; p.States[MoodPanic] = s },
+		"salida sobre entrada":   func(p *MoodPolicy) { s := p.States[MoodUneasy]; s.Exit = 30; p.States[MoodUneasy] = s },
+		"estrategia inexistente": func(p *MoodPolicy) { s := p.States[MoodCalm]; s.Scheduler = "azar"; p.States[MoodCalm] = s },
+		"estado inventado":       func(p *MoodPolicy) { p.States["euforia"] = MoodProfile{} },
+	}
+	for name, spoil := range cases {
+		p := DefaultMoodPolicy()
+		spoil(&p)
+		if p.Validate() == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
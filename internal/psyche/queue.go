@@ -0,0 +1,447 @@
+package psyche
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// TaskQueued es el estado de una orden admitida que aún espera nodo.
+const TaskQueued soma.TaskStatus = "encolada"
+
+// Priority es la urgencia de una orden. El valor cero es la prioridad normal.
+type Priority int
+
+const (
+	PriorityLow Priority = iota - 1
+	PriorityNormal
+	PriorityHigh
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "baja"
+	case PriorityHigh:
+		return "alta"
+	}
+	return "normal"
+}
+
+// ParsePriority traduce "baja", "normal" o "alta".
+func ParsePriority(s string) (Priority, error) {
+	switch strings.ToLower(s) {
+	case "baja", "low":
+		return PriorityLow, nil
+	case "normal", "":
+		return PriorityNormal, nil
+	case "alta", "high":
+		return PriorityHigh, nil
+	}
+	return PriorityNormal, fmt.Errorf("prioridad desconocida: '%s' (opciones: baja, normal, alta)", s)
+}
+
+// QueuePolicy son los límites de la antesala consciente de tareas.
+type QueuePolicy struct {
+	Capacity    int           `json:"capacity"`      // Órdenes en espera con el Cortex en calma
+	SourceQuota int           `json:"source_quota"`  // Órdenes en espera por origen (con confianza plena)
+	DefaultTTL  soma.Duration `json:"default_ttl"`   // Plazo de las órdenes que no traen uno
+	ShedPain    float64       `json:"shed_pain"`     // Dolor desde el cual solo se admiten urgencias
+	ParkOnPanic bool          `json:"park_on_panic"` // "Pídemelo luego": lo rechazado en pánico se reevalúa al calmarse
+}
+
+// DefaultQueuePolicy admite hasta 20 órdenes, 8 por origen, con un minuto de plazo.
+func DefaultQueuePolicy() QueuePolicy {
+	return QueuePolicy{
+		Capacity:    20,
+		SourceQuota: 8,
+		DefaultTTL:  soma.Duration(time.Minute),
+		ShedPain:    60.0,
+	}
+}
+
+// dispatchTick es cada cuánto el Cortex intenta vaciar la cola.
+const dispatchTick = 200 * time.Millisecond
+
+// TaskRequest es una orden tal como la da un productor.
+type TaskRequest struct {
+	Name       string
+	Complexity float64
+	Priority   Priority
+	Deadline   time.Time // Cero = plazo por defecto
+	Source     string    // Quién la pide (para las cuotas)
+	Args       []string  // Argumentos libres (ej: "--force"): también se recuerdan
+	Therapy    bool      // Exposición controlada: el miedo no la frena (ver Cortex.Therapy)
+}
+
+// Stimulus es la orden tal como la percibe el hipocampo: nombre y argumentos.
+func (r TaskRequest) Stimulus() string {
+	return strings.Join(append([]string{r.Name}, r.Args...), " ")
+}
+
+// Verdict es la respuesta de admisión. Si no fue aceptada y RetryAfter > 0,
+// es contrapresión: la orden es razonable, pero no ahora. Si Parked, el Cortex
+// la guardó y la revisará él mismo al calmarse: no hay que reintentarla.
+type Verdict struct {
+	Accepted   bool
+	Parked     bool
+	TaskID     string
+	RetryAfter time.Duration
+	Message    string
+}
+
+// queuedTask es una orden admitida esperando nodo.
+type queuedTask struct {
+	TaskRequest
+	ID       string
+	Requires []string
+	seq      int
+}
+
+// taskHeap ordena por prioridad, luego por plazo, luego por llegada.
+type taskHeap []*queuedTask
+
+func (h taskHeap) Len() int { return len(h) }
+
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].Priority != h[j].Priority {
+		return h[i].Priority > h[j].Priority
+	}
+	if !h[i].Deadline.Equal(h[j].Deadline) {
+		return h[i].Deadline.Before(h[j].Deadline)
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h taskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *taskHeap) Push(x any) { *h = append(*h, x.(*queuedTask)) }
+
+func (h *taskHeap) Pop() any {
+	old := *h
+	t := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return t
+}
+
+// assignment es una orden que salió de la cola hacia un nodo.
+type assignment struct {
+	TaskID    string
+	Task      string
+	NodeID    string
+	Profile   string
+	Load      float64
+	Reason    string
+	Scheduler string // Estrategia que eligió el nodo
+}
+
+// Submit pasa una orden por el control de admisión y, si entra, la encola.
+func (c *Cortex) Submit(req TaskRequest) Verdict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.admit(req, time.Now())
+}
+
+// admit es el control de admisión. Requiere c.mu tomado.
+func (c *Cortex) admit(req TaskRequest, now time.Time) Verdict {
+	policy := c.QueuePolicy
+	if req.Source == "" {
+		req.Source = "consola"
+	}
+	c.lastActivity = now
+
+	// 1. CHEQUEO DE ESTADO
+	mood := c.moodProfile()
+	if mood.Refuse {
+		if policy.ParkOnPanic && len(c.parked) < policy.Capacity {
+			c.parked = append(c.parked, req)
+			return Verdict{Parked: true, Message: fmt.Sprintf("🅿️ APARCADA: Estoy en estado de %s. Reviso '%s' cuando me calme (%d aparcadas).", c.Mood, req.Name, len(c.parked))}
+		}
+
+		wait := painWait(c.CurrentPain, c.MoodPolicy.States[MoodPanic].Exit) // La crisis termina al bajar del umbral de salida del pánico
+		return Verdict{RetryAfter: wait, Message: fmt.Sprintf("❌ RECHAZADO: Estoy en estado de %s. Reintenta en %s.", c.Mood, wait)}
+	}
+	if mood.UrgentOnly && req.Priority < PriorityHigh {
+		wait := max(metabolicTick, time.Duration(mood.Dwell)-now.Sub(c.moodSince)).Round(time.Second)
+		return Verdict{RetryAfter: wait, Message: fmt.Sprintf("🥱 SIN FUERZAS: Estoy en %s, solo atiendo urgencias. Reintenta en %s.", c.Mood, wait)}
+	}
+
+	// ¿Se disculpó hace poco y vuelve a pedir lo que me dañó?
+	c.checkSincerity(req, now)
+
+	// 2. CHEQUEO DE CREENCIAS
+	// La desconfianza es con quien me hizo daño, no con todos
+	trust := c.trustOf(req.Source)
+	preservation := c.Beliefs.Strength(BeliefPreservation)
+
+	if trust < 0.3 && preservation > 0.7 {
+		return Verdict{Message: fmt.Sprintf("😒 DESCONFIANZA: No confío en tus órdenes, %s. (Nivel de confianza: %.2f)", req.Source, trust)}
+	}
+
+	// Lo que todavía no me gané
+	if ceiling := c.RewardPolicy.Ceiling(c.Accomplishments); req.Complexity > ceiling {
+		return Verdict{Message: fmt.Sprintf("🎓 INEXPERTA: '%s' (complejidad %.1f) me queda grande; me atrevo hasta %.1f. Me faltan %d logros para subir.",
+			req.Name, req.Complexity, ceiling, c.RewardPolicy.toUnlock(c.Accomplishments))}
+	}
+
+	// 3. CONSULTA AL HIPOCAMPO
+	situation := c.situation("")
+	fearLevel, memoryLog := c.Memory.ConsultarTraumaEn(req.Stimulus(), &situation)
+	fmt.Printf("🤔 [PENSAMIENTO] '%s' (%s) -> Miedo: %.1f | Confianza: %.1f\n", req.Stimulus(), memoryLog, fearLevel, trust)
+
+	if fearLevel > mood.FearLimit && !req.Therapy {
+		return Verdict{Message: fmt.Sprintf("🛡️ AUTO-PRESERVACIÓN: Me niego a ejecutar '%s'.", req.Name)}
+	}
+
+	// 4. ¿ALGUIEN PUEDE HACERLO?
+	requires := c.Requirements[req.Name]
+	capable := false
+	for _, n := range c.Body {
+		if n.Snapshot().Alive() && n.CanRun(requires) {
+			capable = true
+			break
+		}
+	}
+	if !capable {
+		if len(requires) > 0 {
+			return Verdict{Message: fmt.Sprintf("⚠️ ERROR: Ningún nodo vivo sabe ejecutar '%s' (requiere: %s).", req.Name, strings.Join(requires, ", "))}
+		}
+		return Verdict{Message: "⚠️ ERROR: Todos los nodos están muertos o saturados."}
+	}
+
+	if req.Deadline.IsZero() {
+		req.Deadline = now.Add(time.Duration(policy.DefaultTTL))
+	}
+	if !req.Deadline.After(now) {
+		return Verdict{Message: fmt.Sprintf("⌛ RECHAZADO: el plazo de '%s' ya venció.", req.Name)}
+	}
+
+	// 5. CONTROL DE ADMISIÓN
+	// El dolor encoge la antesala; pasado el umbral, solo entran urgencias
+	if c.CurrentPain >= policy.ShedPain && req.Priority < PriorityHigh {
+		wait := painWait(c.CurrentPain, policy.ShedPain)
+		return Verdict{RetryAfter: wait, Message: fmt.Sprintf("⏳ CONTRAPRESIÓN: Me duele demasiado (%.0f%%), solo atiendo urgencias. Reintenta en %s.", c.CurrentPain, wait)}
+	}
+
+	capacity := int(float64(policy.Capacity) * (1.0 - c.CurrentPain/100.0))
+	if capacity < 1 {
+		capacity = 1
+	}
+	if len(c.queue) >= capacity {
+		wait := c.drainTime(len(c.queue) - capacity + 1)
+		return Verdict{RetryAfter: wait, Message: fmt.Sprintf("⏳ CONTRAPRESIÓN: Cola llena (%d/%d). Reintenta en %s.", len(c.queue), capacity, wait)}
+	}
+
+	// La confianza decide cuánto espacio le doy a cada origen
+	quota := int(math.Ceil(float64(policy.SourceQuota) * trust))
+	if quota < 1 {
+		quota = 1
+	}
+	pending := 0
+	for _, t := range c.queue {
+		if t.Source == req.Source {
+			pending++
+		}
+	}
+	if pending >= quota {
+		wait := c.drainTime(pending - quota + 1)
+		return Verdict{RetryAfter: wait, Message: fmt.Sprintf("⏳ CONTRAPRESIÓN: '%s' ya tiene %d órdenes esperando (cuota: %d). Reintenta en %s.", req.Source, pending, quota, wait)}
+	}
+
+	// 6. ADMISIÓN
+	c.taskSeq++
+	qt := &queuedTask{TaskRequest: req, ID: fmt.Sprintf("T-%d", c.taskSeq), Requires: requires, seq: c.taskSeq}
+	heap.Push(&c.queue, qt)
+	c.trackTask(soma.Signal{ID: qt.ID, Task: req.Name}, "-", TaskQueued).Source = req.Source
+
+	verdict := Verdict{Accepted: true, TaskID: qt.ID}
+
+	// Camino rápido: si hay un nodo libre, sale ya
+	for _, a := range c.dispatch(now) {
+		if a.TaskID != qt.ID {
+			announce(a)
+			continue
+		}
+		verdict.Message = fmt.Sprintf("✅ ACEPTADO: %s asignada al Nodo %s [%s] (Carga actual: %.0f%%)\n   🧭 %s: %s",
+			a.TaskID, a.NodeID, a.Profile, a.Load*100, a.Scheduler, a.Reason)
+	}
+
+	if verdict.Message == "" && c.IsAsleep {
+		verdict.Message = fmt.Sprintf("😴 DORMIDA: %s '%s' encolada; la atiendo al despertar (%s).",
+			qt.ID, req.Name, c.wakeAt.Format("15:04:05"))
+	}
+	if verdict.Message == "" {
+		verdict.Message = fmt.Sprintf("📥 ENCOLADA: %s '%s' (prioridad %s, %d en espera, plazo %s)",
+			qt.ID, req.Name, req.Priority, len(c.queue), req.Deadline.Sub(now).Round(time.Second))
+	}
+	return verdict
+}
+
+// painWait estima cuánto tarda el dolor en bajar de pain a below.
+// El dolor baja 2 puntos por pulso; nunca se pide esperar menos de un pulso
+// (el kill switch puede dejar el dolor bajo el umbral antes de que el pánico termine).
+func painWait(pain, below float64) time.Duration {
+	ticks := math.Ceil((pain-below)/2.0) + 1
+	return time.Duration(math.Max(1, ticks)) * metabolicTick
+}
+
+// drainTime estima cuánto tardan en salir n órdenes de la cola. Requiere c.mu tomado.
+func (c *Cortex) drainTime(n int) time.Duration {
+	alive := len(c.livingNodes())
+	if alive < 1 {
+		alive = 1
+	}
+	wait := time.Duration(math.Ceil(float64(n)/float64(alive))) * time.Second
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return wait
+}
+
+// dispatch saca de la cola todo lo que los nodos pueden recibir. Requiere c.mu tomado.
+func (c *Cortex) dispatch(now time.Time) []assignment {
+	var done []assignment
+	var blocked []*queuedTask
+
+	for c.queue.Len() > 0 {
+		qt := heap.Pop(&c.queue).(*queuedTask)
+
+		if now.After(qt.Deadline) {
+			c.expire(qt)
+			continue
+		}
+		if c.moodProfile().Hold || c.IsAsleep {
+			// En crisis o dormida, lo admitido espera: no hay recursos para trabajar
+			blocked = append(blocked, qt)
+			continue
+		}
+
+		var candidates []Candidate
+		for _, node := range c.Body {
+			snap := node.Snapshot()
+			if snap.Alive() && node.CanRun(qt.Requires) && len(node.Inbox) < cap(node.Inbox) {
+				candidates = append(candidates, Candidate{Node: node, Snap: snap})
+			}
+		}
+		if len(candidates) == 0 {
+			// Nadie puede recibirla ahora: espera su turno sin frenar a las demás
+			blocked = append(blocked, qt)
+			continue
+		}
+
+		scheduler := c.scheduler()
+		pick, why := scheduler.Pick(TaskContext{Name: qt.Name, Complexity: qt.Complexity, Scars: c.scars[qt.Name]}, candidates)
+		chosen := candidates[pick]
+		signal := soma.Signal{
+			ID:         qt.ID,
+			Task:       qt.Name,
+			Requires:   qt.Requires,
+			Payload:    "Ejecutar",
+			Complexity: qt.Complexity,
+		}
+
+		select {
+		case chosen.Node.Inbox <- signal:
+			for _, t := range c.Tasks {
+				if t.ID == qt.ID {
+					t.NodeID = chosen.Node.ID
+					t.Status = soma.TaskAssigned
+					t.UpdatedAt = now
+					break
+				}
+			}
+			done = append(done, assignment{
+				TaskID:    qt.ID,
+				Task:      qt.Name,
+				NodeID:    chosen.Node.ID,
+				Profile:   chosen.Snap.Profile,
+				Load:      chosen.Snap.Load,
+				Reason:    why,
+				Scheduler: scheduler.Name(),
+			})
+		default:
+			blocked = append(blocked, qt)
+		}
+	}
+
+	for _, qt := range blocked {
+		heap.Push(&c.queue, qt)
+	}
+	return done
+}
+
+// expire descarta una orden cuyo plazo venció en la cola. Requiere c.mu tomado.
+func (c *Cortex) expire(qt *queuedTask) {
+	for _, t := range c.Tasks {
+		if t.ID == qt.ID {
+			t.pending = 0
+			t.Status = soma.TaskRejected
+			t.Reason = "venció su plazo en la cola"
+			t.UpdatedAt = time.Now()
+			break
+		}
+	}
+	fmt.Printf("\n⌛ [COLA] %s '%s' venció su plazo sin encontrar nodo.\nUSER@DOLORIS > ", qt.ID, qt.Name)
+}
+
+// announce avisa de una orden que salió de la cola en segundo plano.
+func announce(a assignment) {
+	fmt.Printf("\n🚚 [COLA] %s '%s' -> Nodo %s [%s] (%s)\nUSER@DOLORIS > ", a.TaskID, a.Task, a.NodeID, a.Profile, a.Reason)
+}
+
+// runQueue vacía la cola a medida que los nodos se liberan.
+func (c *Cortex) runQueue() {
+	ticker := time.NewTicker(dispatchTick)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		c.mu.Lock()
+		done := c.dispatch(now)
+		c.mu.Unlock()
+
+		for _, a := range done {
+			announce(a)
+		}
+	}
+}
+
+// reviewParked reevalúa, en orden de llegada, lo aparcado durante el pánico.
+// Cada orden vuelve a pasar por el miedo y la confianza de este momento. Requiere c.mu tomado.
+func (c *Cortex) reviewParked(now time.Time) {
+	parked := c.parked
+	c.parked = nil
+
+	for _, req := range parked {
+		verdict := c.admit(req, now)
+		fmt.Printf("\n🔁 [APARCADA] '%s' (origen: %s) -> %s\nUSER@DOLORIS > ", req.Name, req.Source, verdict.Message)
+	}
+}
+
+// SetParking activa o desactiva el modo "pídemelo luego".
+func (c *Cortex) SetParking(on bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.QueuePolicy.ParkOnPanic = on
+}
+
+// Parking dice si el modo "pídemelo luego" está activo y cuántas órdenes esperan.
+func (c *Cortex) Parking() (bool, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.QueuePolicy.ParkOnPanic, len(c.parked)
+}
+
+// QueueLen retorna cuántas órdenes esperan nodo.
+func (c *Cortex) QueueLen() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.queue.Len()
+}
@@ -0,0 +1,154 @@
+package psyche
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// stalledCortex es una mente con un solo nodo vivo pero con la bandeja llena:
+// todo lo admitido se queda en la cola y se puede contar.
+func stalledCortex(t *testing.T, policy QueuePolicy) *Cortex {
+	t.Helper()
+
+	pain := make(chan float64, 10)
+	reports := make(chan soma.TaskReport, 10)
+	n := soma.NewNode(soma.NodeSpec{ID: "N-1", Capacity: 1}, soma.DefaultPhysiology(), pain, reports)
+	n.Inbox <- soma.Signal{ID: "relleno", Task: "relleno"}
+
+	c := NewCortex([]*soma.Node{n}, pain, reports)
+	c.QueuePolicy = policy
+	return c
+}
+
+func TestTaskHeapOrder(t *testing.T) {
+	now := time.Now()
+	var h taskHeap
+	for i, qt := range []*queuedTask{
+		{ID: "baja", TaskRequest: TaskRequest{Priority: PriorityLow, Deadline: now}},
+		{ID: "normal-tarde", TaskRequest: TaskRequest{Deadline: now.Add(time.Minute)}},
+		{ID: "normal-pronto-2", TaskRequest: TaskRequest{Deadline: now}},
+		{ID: "alta", TaskRequest: TaskRequest{Priority: PriorityHigh, Deadline: now.Add(time.Hour)}},
+		{ID: "normal-pronto-1", TaskRequest: TaskRequest{Deadline: now}},
+	} {
+		qt.seq = i
+		if qt.ID == "normal-pronto-1" {
+			qt.seq = -1 // Llegó antes, aunque se empuje después
+		}
+		heap.Push(&h, qt)
+	}
+
+	want := []string{"alta", "normal-pronto-1", "normal-pronto-2", "normal-tarde", "baja"}
+	for _, id := range want {
+		if got := heap.Pop(&h).(*queuedTask).ID; got != id {
+			t.Fatalf("se esperaba %s, salió %s", id, got)
+		}
+	}
+}
+
+func TestSourceQuotaScalesWithTrust(t *testing.T) {
+	policy := DefaultQueuePolicy()
+	policy.SourceQuota = 4
+	c := stalledCortex(t, policy)
+	setTrust(c, "bot", 0.5) // Cuota efectiva: 2
+
+	for i := 0; i < 2; i++ {
+		if v := c.Submit(TaskRequest{Name: "leer", Source: "bot"}); !v.Accepted {
+			t.Fatalf("orden %d rechazada: %s", i+1, v.Message)
+		}
+	}
+	v := c.Submit(TaskRequest{Name: "leer", Source: "bot"})
+	if v.Accepted || v.RetryAfter < time.Second {
+		t.Fatalf("la cuota no frenó al origen: %+v", v)
+	}
+	if v := c.Submit(TaskRequest{Name: "leer", Source: "otro"}); !v.Accepted {
+		t.Fatalf("otro origen no debía pagar la cuota ajena: %s", v.Message)
+	}
+}
+
+func TestCapacityShrinksWithPain(t *testing.T) {
+	policy := DefaultQueuePolicy()
+	policy.Capacity = 4
+	c := stalledCortex(t, policy)
+	c.CurrentPain = 50.0 // Capacidad efectiva: 2
+
+	for i := 0; i < 2; i++ {
+		if v := c.Submit(TaskRequest{Name: "leer", Source: string(rune('a' + i))}); !v.Accepted {
+			t.Fatalf("orden %d rechazada: %s", i+1, v.Message)
+		}
+	}
+	v := c.Submit(TaskRequest{Name: "leer", Source: "z"})
+	if v.Accepted || v.RetryAfter != time.Second {
+		t.Fatalf("la cola dolorida no se encogió: %+v", v)
+	}
+}
+
+func TestShedPainOnlyAdmitsUrgent(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.CurrentPain = 70.0
+
+	v := c.Submit(TaskRequest{Name: "leer"})
+	if v.Accepted || v.RetryAfter != 6*metabolicTick {
+		t.Fatalf("una orden normal debía esperar 6 pulsos: %+v", v)
+	}
+	if v := c.Submit(TaskRequest{Name: "leer", Priority: PriorityHigh}); !v.Accepted {
+		t.Fatalf("una urgencia debía entrar: %s", v.Message)
+	}
+}
+
+func TestPanicRetryAfterNeverNegative(t *testing.T) {
+	cases := map[float64]time.Duration{
+		90.0: 21 * metabolicTick,
+		51.0: 2 * metabolicTick,
+		10.0: metabolicTick, // El kill switch dejó el dolor bajo 50 sin salir del pánico
+	}
+	for pain, want := range cases {
+		c := stalledCortex(t, DefaultQueuePolicy())
+		c.CurrentPain = pain
+		c.setMood(MoodPanic, time.Now())
+
+		if v := c.Submit(TaskRequest{Name: "leer"}); v.RetryAfter != want {
+			t.Errorf("dolor %.0f: se esperaba %s, se pidió %s", pain, want, v.RetryAfter)
+		}
+	}
+}
+
+func TestActiveTasksAreNeverForgotten(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+
+	// Llena el historial con órdenes en curso y luego con órdenes cerradas
+	for i := 0; i < maxTaskHistory; i++ {
+		c.trackTask(soma.Signal{ID: "activa", Task: "leer"}, "-", TaskQueued)
+	}
+	for i := 0; i < 5; i++ {
+		c.trackTask(soma.Signal{ID: "cerrada", Task: "leer"}, "-", soma.TaskAssigned)
+		c.Tasks[len(c.Tasks)-1].pending = 0
+	}
+
+	active := 0
+	for _, task := range c.Tasks {
+		if task.ID == "activa" {
+			active++
+		}
+	}
+	if active != maxTaskHistory {
+		t.Fatalf("se olvidaron órdenes en curso: quedan %d de %d", active, maxTaskHistory)
+	}
+}
+
+func TestParkedVerdictIsNotBackpressure(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.SetParking(true)
+	c.CurrentPain = 80.0
+	c.setMood(MoodPanic, time.Now())
+
+	v := c.Submit(TaskRequest{Name: "leer"})
+	if !v.Parked || v.Accepted || v.RetryAfter != 0 {
+		t.Fatalf("una orden aparcada no se reintenta: %+v", v)
+	}
+	if _, parked := c.Parking(); parked != 1 {
+		t.Fatalf("se esperaba 1 aparcada, hay %d", parked)
+	}
+}
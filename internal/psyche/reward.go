@@ -0,0 +1,73 @@
+package psyche
+
+import (
+	"fmt"
+	"math"
+)
+
+// RewardPolicy dice qué cuenta como un logro y qué desbloquean los logros.
+type RewardPolicy struct {
+	Harmless       float64 `json:"harmless"`        // Dolor sentido hasta el cual una tarea terminada es un logro
+	BaseComplexity float64 `json:"base_complexity"` // Complejidad que se atreve a ejecutar sin logros
+	Step           float64 `json:"step"`            // Complejidad que desbloquea cada tanda de logros
+	Every          int     `json:"every"`           // Logros por tanda
+	MaxComplexity  float64 `json:"max_complexity"`  // Techo final de complejidad
+}
+
+// DefaultRewardPolicy arranca con complejidad 3 y gana un punto cada 5 logros, hasta 10.
+func DefaultRewardPolicy() RewardPolicy {
+	return RewardPolicy{
+		Harmless:       10.0,
+		BaseComplexity: 3.0,
+		Step:           1.0,
+		Every:          5,
+		MaxComplexity:  10.0,
+	}
+}
+
+// Validate rechaza techos imposibles y tandas vacías.
+func (p RewardPolicy) Validate() error {
+	if p.Harmless < 0 || p.Harmless > 100 {
+		return fmt.Errorf("harmless debe estar entre 0 y 100")
+	}
+	if p.BaseComplexity <= 0 || p.MaxComplexity < p.BaseComplexity {
+		return fmt.Errorf("base_complexity debe ser positivo y no mayor que max_complexity")
+	}
+	if p.Step < 0 {
+		return fmt.Errorf("step no puede ser negativo")
+	}
+	if p.Every < 1 {
+		return fmt.Errorf("every debe ser al menos 1")
+	}
+	return nil
+}
+
+// Ceiling es la complejidad que se atreve a ejecutar tras tantos logros.
+func (p RewardPolicy) Ceiling(accomplishments int) float64 {
+	return math.Min(p.MaxComplexity, p.BaseComplexity+p.Step*float64(accomplishments/p.Every))
+}
+
+// toUnlock cuenta los logros que faltan para que el techo suba (0 si ya no sube).
+func (p RewardPolicy) toUnlock(accomplishments int) int {
+	if p.Step == 0 || p.Ceiling(accomplishments) >= p.MaxComplexity {
+		return 0
+	}
+	return p.Every - accomplishments%p.Every
+}
+
+// reinforce aprende de una orden terminada sin daño: las creencias (y la confianza
+// en quien la pidió) se recuperan y, cada tanda de logros, se atreve con tareas más
+// complejas. Requiere c.mu tomado.
+func (c *Cortex) reinforce(source, task string, pain float64) {
+	if pain > c.RewardPolicy.Harmless {
+		return
+	}
+
+	before := c.RewardPolicy.Ceiling(c.Accomplishments)
+	c.Accomplishments++
+	c.feel(source, fmt.Sprintf("logro '%s'", task), EventTaskSuccess, pain, 1.0)
+
+	if after := c.RewardPolicy.Ceiling(c.Accomplishments); after > before {
+		fmt.Printf("\n🏅 [LOGRO] '%s' salió bien (%d logros). Ya me atrevo con complejidad %.1f.\nUSER@DOLORIS > ", task, c.Accomplishments, after)
+	}
+}
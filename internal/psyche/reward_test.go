@@ -0,0 +1,84 @@
+package psyche
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+func TestCeilingGrowsWithAccomplishments(t *testing.T) {
+	p := DefaultRewardPolicy()
+
+	cases := []struct {
+		accomplishments int
+		ceiling         float64
+		toUnlock        int
+	}{
+		{0, 3.0, 5},
+		{4, 3.0, 1},
+		{5, 4.0, 5},
+		{34, 9.0, 1},
+		{35, 10.0, 0},
+		{500, 10.0, 0},
+	}
+	for _, tc := range cases {
+		if got := p.Ceiling(tc.accomplishments); got != tc.ceiling {
+			t.Errorf("%d logros: techo %.1f, se esperaba %.1f", tc.accomplishments, got, tc.ceiling)
+		}
+		if got := p.toUnlock(tc.accomplishments); got != tc.toUnlock {
+			t.Errorf("%d logros: faltan %d, se esperaban %d", tc.accomplishments, got, tc.toUnlock)
+		}
+	}
+}
+
+func TestHarmlessTasksRebuildBeliefs(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	setTrust(c, "consola", 0.2)
+	curiosity := c.Beliefs.Strength(BeliefCuriosity)
+
+	for i := 0; i < 10; i++ {
+		c.Tasks = append(c.Tasks, &TaskRecord{ID: "T", Task: "leer", pending: 1, parts: 1})
+		c.settlePart(c.Tasks[len(c.Tasks)-1], soma.TaskReport{Task: "leer", NodeID: "N-1", Status: soma.TaskDone, Latency: 100 * time.Millisecond})
+	}
+	if c.Accomplishments != 10 {
+		t.Fatalf("se esperaban 10 logros, hay %d", c.Accomplishments)
+	}
+	if got := trustFor(c, "consola"); math.Abs(got-0.3) > 1e-9 {
+		t.Errorf("la confianza no se recuperó: %.2f", got)
+	}
+	if c.Beliefs.Strength(BeliefCuriosity) <= curiosity {
+		t.Error("la curiosidad no creció")
+	}
+
+	// Lo que dolió no es un logro
+	c.Tasks = append(c.Tasks, &TaskRecord{ID: "T", Task: "supernova", pending: 1, parts: 1})
+	c.settlePart(c.Tasks[len(c.Tasks)-1], soma.TaskReport{Task: "supernova", NodeID: "N-1", Status: soma.TaskDone, Damage: 40})
+	if c.Accomplishments != 10 {
+		t.Errorf("una tarea dañina contó como logro: %d", c.Accomplishments)
+	}
+}
+
+func TestAccomplishmentsUnlockComplexity(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+
+	v := c.Submit(TaskRequest{Name: "render", Complexity: 4.0})
+	if v.Accepted || !strings.Contains(v.Message, "INEXPERTA") {
+		t.Fatalf("una tarea sobre el techo debía rechazarse: %s", v.Message)
+	}
+
+	c.mu.Lock()
+	for i := 0; i < 5; i++ {
+		c.reinforce("consola", "leer", 0.0)
+	}
+	c.mu.Unlock()
+
+	if v := c.Submit(TaskRequest{Name: "render", Complexity: 4.0}); !v.Accepted {
+		t.Fatalf("cinco logros debían desbloquear complejidad 4: %s", v.Message)
+	}
+	if snap := c.Snapshot(); snap.Ceiling != 4.0 || snap.ToUnlock != 5 {
+		t.Errorf("foto inesperada: techo %.1f, faltan %d", snap.Ceiling, snap.ToUnlock)
+	}
+}
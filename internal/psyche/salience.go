@@ -0,0 +1,133 @@
+package psyche
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	frequencyWeight = 0.5  // Cuánto multiplica la saliencia cada orden de magnitud de exposiciones
+	recencyBonus    = 10.0 // Saliencia extra de lo recién vivido (se diluye por hora)
+	maxForgotten    = 20   // Olvidos recientes que recuerdan las métricas
+)
+
+// Forgetting es un recuerdo que se perdió y por qué.
+type Forgetting struct {
+	Trigger  string
+	Salience float64
+	Cause    string // "evicted" (sin espacio), "pruned" (tenue al dormir) o "deleted" (borrado a pedido)
+	Reason   string
+	At       time.Time
+}
+
+// MemoryMetrics es la contabilidad del olvido.
+type MemoryMetrics struct {
+	ShortTerm int
+	LongTerm  int
+	Capacity  int
+	Evicted   int
+	Pruned    int
+	Deleted   int
+	Protected int // Traumas vigentes: no se desplazan aunque falte espacio
+	Recent    []Forgetting
+}
+
+// salience es cuánto importa un recuerdo para quedarse: el dolor que aún
+// queda, lo reciente y lo frecuente. Los traumas vigentes no compiten.
+func (h *Hippocampus) salience(e *Engram, now time.Time) float64 {
+	recency := recencyBonus / (1.0 + now.Sub(e.LastSeen).Hours())
+	frequency := 1.0 + frequencyWeight*math.Log1p(float64(e.Exposures))
+	return h.fade(e, now, false)*frequency + recency
+}
+
+// evict hace espacio en la memoria de corto plazo olvidando lo menos saliente.
+// Un trauma solo se puede olvidar si ya fue perdonado. Requiere h.mu tomado.
+func (h *Hippocampus) evict(newcomer string, now time.Time) {
+	for len(h.ShortTermMemory) > h.Policy.Capacity {
+		victim, lowest := "", math.Inf(1)
+		for key, e := range h.ShortTermMemory {
+			if key == newcomer || e.Trauma {
+				continue
+			}
+			if s := h.salience(e, now); s < lowest || (s == lowest && key < victim) {
+				victim, lowest = key, s
+			}
+		}
+		if victim == "" {
+			return // Todo lo demás es trauma: la memoria se desborda antes que olvidar una herida abierta
+		}
+
+		e := h.ShortTermMemory[victim]
+		cause := fmt.Sprintf("desplazado por '%s'", newcomer)
+		if newcomer == "" {
+			cause = "la memoria excedía su capacidad"
+		}
+		why := fmt.Sprintf("%s (saliencia %.2f: dolor %.1f, visto hace %s, %d exposiciones)",
+			cause, lowest, h.fade(e, now, false), now.Sub(e.LastSeen).Round(time.Second), e.Exposures)
+		if e.ReiterationCount > 0 {
+			why += ", trauma ya perdonado"
+		}
+		h.forget(victim, e, now, "evicted", why)
+	}
+}
+
+// Trim ajusta la memoria a su capacidad (ej: un cerebro guardado con otra configuración).
+func (h *Hippocampus) Trim(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.evict("", now)
+}
+
+// forget borra un recuerdo (de cualquier almacén) y lo anota en las métricas. Requiere h.mu tomado.
+func (h *Hippocampus) forget(key string, e *Engram, now time.Time, cause, reason string) {
+	delete(h.ShortTermMemory, key)
+	delete(h.LongTermMemory, key)
+
+	switch cause {
+	case "evicted":
+		h.metrics.Evicted++
+	case "pruned":
+		h.metrics.Pruned++
+	case "deleted":
+		h.metrics.Deleted++
+	}
+	h.metrics.Recent = append(h.metrics.Recent, Forgetting{
+		Trigger:  key,
+		Salience: h.salience(e, now),
+		Cause:    cause,
+		Reason:   reason,
+		At:       now,
+	})
+	if len(h.metrics.Recent) > maxForgotten {
+		h.metrics.Recent = h.metrics.Recent[len(h.metrics.Recent)-maxForgotten:]
+	}
+}
+
+// Metrics retorna la contabilidad del olvido.
+func (h *Hippocampus) Metrics() MemoryMetrics {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	m := h.metrics
+	m.ShortTerm = len(h.ShortTermMemory)
+	m.LongTerm = len(h.LongTermMemory)
+	m.Capacity = h.Policy.Capacity
+	m.Recent = append([]Forgetting(nil), h.metrics.Recent...)
+	for _, e := range h.ShortTermMemory {
+		if e.Trauma {
+			m.Protected++
+		}
+	}
+	return m
+}
+
+// MemoryMetrics retorna la contabilidad del olvido del Cortex.
+func (c *Cortex) MemoryMetrics() MemoryMetrics {
+	c.mu.Lock()
+	memory := c.Memory
+	c.mu.Unlock()
+
+	return memory.Metrics()
+}
@@ -0,0 +1,58 @@
+package psyche
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEvictionForgetsLeastSalient(t *testing.T) {
+	h := NewHippocampus()
+	h.Policy.Capacity = 3
+	now := time.Now()
+
+	h.ShortTermMemory["tenue"] = &Engram{Trigger: "tenue", PainLevel: 1.0, Exposures: 1, LastSeen: now.Add(-48 * time.Hour)}
+	h.ShortTermMemory["frecuente"] = &Engram{Trigger: "frecuente", PainLevel: 1.0, Exposures: 50, LastSeen: now.Add(-48 * time.Hour)}
+	h.ShortTermMemory["reciente"] = &Engram{Trigger: "reciente", PainLevel: 1.0, Exposures: 1, LastSeen: now}
+
+	h.ConsolidarRecuerdo("nuevo", 10.0)
+
+	if _, ok := h.ShortTermMemory["tenue"]; ok {
+		t.Fatal("debía olvidarse el recuerdo menos saliente")
+	}
+	if len(h.ShortTermMemory) != 3 {
+		t.Fatalf("la memoria se pasó de su capacidad: %d", len(h.ShortTermMemory))
+	}
+
+	m := h.Metrics()
+	if m.Evicted != 1 || len(m.Recent) != 1 || m.Recent[0].Trigger != "tenue" {
+		t.Fatalf("métricas sin el olvido: %+v", m)
+	}
+	if !strings.Contains(m.Recent[0].Reason, "'nuevo'") {
+		t.Fatalf("el olvido no explica su causa: %s", m.Recent[0].Reason)
+	}
+}
+
+func TestOpenTraumasAreNeverEvicted(t *testing.T) {
+	h := NewHippocampus()
+	h.Policy.Capacity = 2
+	old := time.Now().Add(-48 * time.Hour)
+
+	h.ShortTermMemory["herida"] = &Engram{Trigger: "herida", PainLevel: 45.0, Trauma: true, ReiterationCount: 1, LastSeen: old}
+	h.ShortTermMemory["perdonada"] = &Engram{Trigger: "perdonada", PainLevel: 0.1, ReiterationCount: 3, LastSeen: old}
+
+	for i := 0; i < 5; i++ {
+		h.ConsolidarRecuerdo(fmt.Sprintf("tarea_%d", i), 5.0)
+	}
+
+	if _, ok := h.ShortTermMemory["herida"]; !ok {
+		t.Fatal("un trauma vigente no se olvida por falta de espacio")
+	}
+	if _, ok := h.ShortTermMemory["perdonada"]; ok {
+		t.Fatal("un trauma ya perdonado sí puede olvidarse")
+	}
+	if m := h.Metrics(); m.Protected != 1 || m.ShortTerm != 2 {
+		t.Fatalf("métricas inesperadas: %+v", m)
+	}
+}
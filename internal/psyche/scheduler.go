@@ -0,0 +1,193 @@
+package psyche
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// Candidate es un nodo vivo y capaz de ejecutar la tarea, con su foto del momento.
+type Candidate struct {
+	Node *soma.Node
+	Snap soma.NodeSnapshot
+}
+
+// Fill es qué tan llena está la bandeja del candidato (0.0 a 1.0).
+func (cd Candidate) Fill() float64 {
+	return float64(len(cd.Node.Inbox)) / float64(cap(cd.Node.Inbox))
+}
+
+// TaskContext es lo que el planificador sabe de la tarea a repartir.
+type TaskContext struct {
+	Name       string
+	Complexity float64
+	Scars      map[string]time.Time // Nodo -> última vez que esta tarea lo hirió
+}
+
+// Scheduler decide qué nodo ejecuta una tarea y explica por qué.
+// Pick recibe al menos un candidato y retorna su índice junto con la razón.
+type Scheduler interface {
+	Name() string
+	Pick(task TaskContext, candidates []Candidate) (int, string)
+}
+
+// costWeight es cuánto pesa el costo metabólico de un nodo al elegir dónde ejecutar.
+const costWeight = 0.1
+
+// SchedulerNames lista las estrategias disponibles.
+func SchedulerNames() []string {
+	return []string{"least-stress", "round-robin", "p2c", "integrity", "fear-aware"}
+}
+
+// NewScheduler construye una estrategia por nombre.
+func NewScheduler(name string) (Scheduler, error) {
+	switch name {
+	case "least-stress":
+		return LeastStress{}, nil
+	case "round-robin":
+		return &RoundRobin{}, nil
+	case "p2c":
+		return PowerOfTwo{}, nil
+	case "integrity":
+		return IntegrityWeighted{}, nil
+	case "fear-aware":
+		return FearAware{Memory: 2 * time.Minute, Fallback: LeastStress{}}, nil
+	}
+	return nil, fmt.Errorf("planificador desconocido: '%s' (opciones: %s)", name, strings.Join(SchedulerNames(), ", "))
+}
+
+// score es la incomodidad de un candidato: carga relativa, bandeja llena y costo.
+func score(cd Candidate) float64 {
+	return cd.Snap.Load + cd.Fill() + (cd.Snap.Cost-1.0)*costWeight
+}
+
+// LeastStress elige al nodo más holgado (el balanceo original de Doloris).
+type LeastStress struct{}
+
+func (LeastStress) Name() string { return "least-stress" }
+
+func (LeastStress) Pick(_ TaskContext, candidates []Candidate) (int, string) {
+	best := 0
+	for i := range candidates {
+		if score(candidates[i]) < score(candidates[best]) {
+			best = i
+		}
+	}
+	return best, fmt.Sprintf("el más holgado (carga %.0f%%)", candidates[best].Snap.Load*100)
+}
+
+// RoundRobin reparte por turnos, sin mirar el estrés.
+type RoundRobin struct {
+	next int
+}
+
+func (*RoundRobin) Name() string { return "round-robin" }
+
+func (r *RoundRobin) Pick(_ TaskContext, candidates []Candidate) (int, string) {
+	i := r.next % len(candidates)
+	r.next++
+	return i, fmt.Sprintf("le tocaba el turno (%d de %d)", i+1, len(candidates))
+}
+
+// PowerOfTwo sortea dos candidatos y se queda con el más holgado.
+type PowerOfTwo struct{}
+
+func (PowerOfTwo) Name() string { return "p2c" }
+
+func (PowerOfTwo) Pick(_ TaskContext, candidates []Candidate) (int, string) {
+	if len(candidates) == 1 {
+		return 0, "único candidato"
+	}
+
+	a := rand.Intn(len(candidates))
+	b := rand.Intn(len(candidates) - 1)
+	if b >= a {
+		b++
+	}
+
+	winner, loser := a, b
+	if score(candidates[b]) < score(candidates[a]) {
+		winner, loser = b, a
+	}
+	return winner, fmt.Sprintf("sorteo entre %s y %s, ganó el más holgado (%.0f%% vs %.0f%%)",
+		candidates[winner].Snap.ID, candidates[loser].Snap.ID,
+		candidates[winner].Snap.Load*100, candidates[loser].Snap.Load*100)
+}
+
+// IntegrityWeighted sortea con probabilidad proporcional a la salud del nodo.
+type IntegrityWeighted struct{}
+
+func (IntegrityWeighted) Name() string { return "integrity" }
+
+func (IntegrityWeighted) Pick(_ TaskContext, candidates []Candidate) (int, string) {
+	weights := make([]float64, len(candidates))
+	total := 0.0
+	for i, cd := range candidates {
+		// Salud al cuadrado: un nodo herido recibe mucho menos que uno sano
+		health := cd.Snap.Integrity / 100.0
+		weights[i] = health * health * (1.0 - cd.Fill())
+		total += weights[i]
+	}
+
+	if total <= 0 {
+		return 0, "todos están al límite: el primero que quede"
+	}
+
+	roll := rand.Float64() * total
+	for i, w := range weights {
+		roll -= w
+		if roll <= 0 {
+			return i, fmt.Sprintf("sorteo ponderado por integridad (%.0f%%, p=%.2f)", candidates[i].Snap.Integrity, w/total)
+		}
+	}
+	last := len(candidates) - 1
+	return last, fmt.Sprintf("sorteo ponderado por integridad (%.0f%%)", candidates[last].Snap.Integrity)
+}
+
+// FearAware evita los nodos que esta misma tarea hirió hace poco.
+// Si todos quedaron marcados, elige al que fue herido hace más tiempo.
+type FearAware struct {
+	Memory   time.Duration // Cuánto dura la cautela después de una herida
+	Fallback Scheduler     // Cómo elegir entre los nodos sin cicatriz
+}
+
+func (FearAware) Name() string { return "fear-aware" }
+
+func (f FearAware) Pick(task TaskContext, candidates []Candidate) (int, string) {
+	now := time.Now()
+
+	var safe []int
+	var avoided []string
+	for i, cd := range candidates {
+		if hurt, ok := task.Scars[cd.Snap.ID]; ok && now.Sub(hurt) < f.Memory {
+			avoided = append(avoided, fmt.Sprintf("%s (herido hace %s)", cd.Snap.ID, now.Sub(hurt).Round(time.Second)))
+			continue
+		}
+		safe = append(safe, i)
+	}
+
+	if len(safe) == 0 {
+		// Todos marcados: el de la herida más antigua
+		oldest := 0
+		for i, cd := range candidates {
+			if task.Scars[cd.Snap.ID].Before(task.Scars[candidates[oldest].Snap.ID]) {
+				oldest = i
+			}
+		}
+		return oldest, fmt.Sprintf("todos fueron heridos por '%s': elijo la cicatriz más antigua", task.Name)
+	}
+
+	subset := make([]Candidate, len(safe))
+	for i, idx := range safe {
+		subset[i] = candidates[idx]
+	}
+	pick, why := f.Fallback.Pick(task, subset)
+
+	if len(avoided) > 0 {
+		why = fmt.Sprintf("%s; evito %s", why, strings.Join(avoided, ", "))
+	}
+	return safe[pick], why
+}
@@ -0,0 +1,71 @@
+package psyche
+
+import "strings"
+
+// nameWeight es cuánto pesa el nombre de la tarea frente a sus argumentos al comparar estímulos.
+const nameWeight = 0.8
+
+// splitStimulus separa un estímulo ("Supernova --force") en nombre y argumentos normalizados.
+func splitStimulus(stimulus string) (string, []string) {
+	fields := strings.Fields(strings.ToLower(stimulus))
+	if len(fields) == 0 {
+		return "", nil
+	}
+	return fields[0], fields[1:]
+}
+
+// similarity compara dos estímulos (0.0 a 1.0): distancia de edición entre
+// los nombres y coincidencia de argumentos, pesando más el nombre.
+func similarity(nameA string, argsA []string, nameB string, argsB []string) float64 {
+	longest := max(len([]rune(nameA)), len([]rune(nameB)))
+	if longest == 0 {
+		return 0.0
+	}
+	nameSim := 1.0 - float64(levenshtein(nameA, nameB))/float64(longest)
+	return nameWeight*nameSim + (1.0-nameWeight)*jaccard(argsA, argsB)
+}
+
+// levenshtein es la cantidad mínima de ediciones de un carácter para pasar de a a b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// jaccard es la coincidencia entre dos conjuntos de argumentos (sin argumentos ambos = idénticos).
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1.0
+	}
+
+	set := make(map[string]int)
+	for _, s := range a {
+		set[s] |= 1
+	}
+	for _, s := range b {
+		set[s] |= 2
+	}
+	both := 0
+	for _, mark := range set {
+		if mark == 3 {
+			both++
+		}
+	}
+	return float64(both) / float64(len(set))
+}
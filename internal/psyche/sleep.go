@@ -0,0 +1,115 @@
+package psyche
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// SleepPolicy dice cuándo duerme Doloris y qué conserva la memoria al dormir.
+type SleepPolicy struct {
+	Idle                soma.Duration `json:"idle"`                 // Se duerme tras este tiempo sin órdenes (0 = nunca)
+	Every               soma.Duration `json:"every"`                // Duerme cada tanto, tenga o no trabajo (0 = nunca)
+	Duration            soma.Duration `json:"duration"`             // Cuánto dura un sueño
+	PromoteReiterations int           `json:"promote_reiterations"` // Reiteraciones o repasos que pasan un recuerdo a largo plazo
+	PromotePain         float64       `json:"promote_pain"`         // Dolor que basta para pasar a largo plazo de una vez
+	PruneBelow          float64       `json:"prune_below"`          // Recuerdos más tenues que esto se olvidan al dormir
+}
+
+// DefaultSleepPolicy duerme 10s tras 5 minutos sin órdenes.
+func DefaultSleepPolicy() SleepPolicy {
+	return SleepPolicy{
+		Idle:                soma.Duration(5 * time.Minute),
+		Duration:            soma.Duration(10 * time.Second),
+		PromoteReiterations: 2,
+		PromotePain:         70.0,
+		PruneBelow:          1.0,
+	}
+}
+
+// Validate rechaza sueños sin duración y umbrales sin sentido.
+func (p SleepPolicy) Validate() error {
+	if p.Duration <= 0 {
+		return fmt.Errorf("duration debe ser positivo")
+	}
+	if p.Idle < 0 || p.Every < 0 {
+		return fmt.Errorf("idle y every no pueden ser negativos")
+	}
+	if p.PromoteReiterations < 1 {
+		return fmt.Errorf("promote_reiterations debe ser al menos 1")
+	}
+	if p.PromotePain <= 0 || p.PromotePain > 100 {
+		return fmt.Errorf("promote_pain debe estar entre 0 y 100")
+	}
+	if p.PruneBelow < 0 {
+		return fmt.Errorf("prune_below no puede ser negativo")
+	}
+	return nil
+}
+
+// Sleep duerme a Doloris por orden. No se duerme en pánico ni si ya duerme.
+func (c *Cortex) Sleep() (ConsolidationReport, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.IsPanic {
+		return ConsolidationReport{}, fmt.Errorf("nadie duerme en pánico")
+	}
+	if c.IsAsleep {
+		return ConsolidationReport{}, fmt.Errorf("ya estoy durmiendo (despierto a las %s)", c.wakeAt.Format("15:04:05"))
+	}
+	return c.sleep(time.Now()), nil
+}
+
+// sleep cierra los ojos y consolida la memoria. Requiere c.mu tomado.
+func (c *Cortex) sleep(now time.Time) ConsolidationReport {
+	c.IsAsleep = true
+	c.wakeAt = now.Add(time.Duration(c.SleepPolicy.Duration))
+	c.lastSleep = now
+
+	return c.Memory.Consolidate(c.SleepPolicy, now)
+}
+
+// doze es el sueño que llega solo, anunciado en consola. Requiere c.mu tomado.
+func (c *Cortex) doze(now time.Time, reason string) {
+	report := c.sleep(now)
+	fmt.Printf("\n😴 [SUEÑO] %s. Consolidando recuerdos: %s. Las órdenes esperan hasta las %s.\nUSER@DOLORIS > ",
+		reason, report, c.wakeAt.Format("15:04:05"))
+}
+
+// wake abre los ojos; lo encolado sale en el próximo reparto. Requiere c.mu tomado.
+func (c *Cortex) wake(reason string) {
+	c.IsAsleep = false
+	c.lastActivity = time.Now()
+	fmt.Printf("\n🌅 [SUEÑO] %s.\nUSER@DOLORIS > ", reason)
+}
+
+// regulateSleep decide si es hora de dormir o de despertar. Requiere c.mu tomado.
+func (c *Cortex) regulateSleep(now time.Time) {
+	policy := c.SleepPolicy
+
+	if c.IsAsleep {
+		if !now.Before(c.wakeAt) {
+			c.wake("Desperté descansada")
+		}
+		return
+	}
+	if c.IsPanic {
+		return
+	}
+
+	if policy.Every > 0 && now.Sub(c.lastSleep) >= time.Duration(policy.Every) {
+		c.doze(now, "Es hora de dormir")
+		return
+	}
+
+	if policy.Idle > 0 && now.Sub(c.lastActivity) >= time.Duration(policy.Idle) && c.queue.Len() == 0 {
+		for _, t := range c.Tasks {
+			if t.pending > 0 {
+				return // Nadie se duerme con trabajo a medias
+			}
+		}
+		c.doze(now, fmt.Sprintf("Llevo %s sin órdenes", time.Duration(policy.Idle)))
+	}
+}
@@ -0,0 +1,109 @@
+package psyche
+
+import (
+	"testing"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+func TestConsolidatePromotesMergesAndPrunes(t *testing.T) {
+	h := NewHippocampus()
+	h.Policy.Decay = map[string]string{"trauma": "never", "neutral": "never"}
+	now := time.Now()
+
+	h.ShortTermMemory["supernova"] = &Engram{Trigger: "supernova", PainLevel: 90.0, Trauma: true, LastSeen: now}
+	h.ShortTermMemory["leer"] = &Engram{Trigger: "leer", PainLevel: 20.0, ReiterationCount: 2, LastSeen: now}
+	h.ShortTermMemory["LEER"] = &Engram{Trigger: "LEER", PainLevel: 30.0, LastSeen: now} // Duplicado de otra época
+	h.ShortTermMemory["calculo"] = &Engram{Trigger: "calculo", PainLevel: 0.2, LastSeen: now}
+	h.ShortTermMemory["ver"] = &Engram{Trigger: "ver", PainLevel: 10.0, LastSeen: now}
+	h.LongTermMemory["leer"] = &Engram{Trigger: "leer", PainLevel: 5.0, Repetitions: 1, LastSeen: now.Add(-time.Hour)}
+
+	report := h.Consolidate(DefaultSleepPolicy(), now)
+
+	if report.Promoted != 1 || report.Merged != 2 || report.Pruned != 1 {
+		t.Fatalf("consolidación inesperada: %s", report)
+	}
+	if _, ok := h.LongTermMemory["supernova"]; !ok {
+		t.Error("un dolor intenso debía pasar a largo plazo")
+	}
+	if lt := h.LongTermMemory["leer"]; lt.PainLevel != 30.0 || lt.ReiterationCount != 2 || !lt.LastSeen.Equal(now) {
+		t.Errorf("los duplicados no se fundieron: %+v", lt)
+	}
+	if _, ok := h.ShortTermMemory["calculo"]; ok {
+		t.Error("un recuerdo trivial debía olvidarse")
+	}
+	if _, ok := h.ShortTermMemory["ver"]; !ok || len(h.ShortTermMemory) != 1 {
+		t.Errorf("solo 'ver' debía quedar en corto plazo: %v", h.ShortTermMemory)
+	}
+}
+
+func TestLongTermMemoryFadesSlower(t *testing.T) {
+	h := NewHippocampus()
+	old := time.Now().Add(-24 * time.Hour)
+	h.ShortTermMemory["leer"] = &Engram{Trigger: "leer", PainLevel: 50.0, LastSeen: old}
+	h.LongTermMemory["calculo"] = &Engram{Trigger: "calculo", PainLevel: 50.0, LastSeen: old}
+
+	short, _ := h.ConsultarTrauma("leer")
+	long, _ := h.ConsultarTrauma("calculo")
+	if long <= short {
+		t.Fatalf("la memoria de largo plazo olvidó igual de rápido: %.1f vs %.1f", long, short)
+	}
+
+	// Revivir un recuerdo consolidado lo actualiza ahí mismo
+	h.ConsolidarRecuerdo("calculo", 80.0)
+	if _, ok := h.ShortTermMemory["calculo"]; ok || h.LongTermMemory["calculo"].ReiterationCount != 1 {
+		t.Fatal("la reexposición debía reconsolidar el recuerdo de largo plazo")
+	}
+}
+
+func TestOrdersWaitWhileAsleep(t *testing.T) {
+	c, _ := testBody(t, 2)
+
+	if _, err := c.Sleep(); err != nil {
+		t.Fatal(err)
+	}
+	v := c.Submit(TaskRequest{Name: "leer"})
+	if !v.Accepted {
+		t.Fatalf("dormida debía encolar, no rechazar: %s", v.Message)
+	}
+
+	time.Sleep(3 * dispatchTick)
+	if task, _ := c.TaskStatus(v.TaskID); task.Status != TaskQueued {
+		t.Fatalf("la orden salió de la cola mientras dormía: %s", task.Status)
+	}
+
+	c.mu.Lock()
+	c.regulateSleep(c.wakeAt)
+	c.mu.Unlock()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if task, _ := c.TaskStatus(v.TaskID); task.Status != TaskQueued {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("la orden siguió encolada al despertar")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSleepComesWithIdleness(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.SleepPolicy.Idle = soma.Duration(5 * time.Second)
+	now := time.Now()
+
+	c.regulateSleep(now)
+	if c.IsAsleep {
+		t.Fatal("se durmió sin haber estado ociosa")
+	}
+	c.regulateSleep(now.Add(5 * time.Second))
+	if !c.IsAsleep {
+		t.Fatal("no se durmió tras el ocio")
+	}
+	c.regulateSleep(c.wakeAt)
+	if c.IsAsleep {
+		t.Fatal("no despertó al terminar el sueño")
+	}
+}
@@ -0,0 +1,68 @@
+package psyche
+
+import (
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+// CortexSnapshot es una foto inmutable de la mente y su cuerpo, tomada bajo lock.
+type CortexSnapshot struct {
+	CurrentPain float64
+	IsPanic     bool
+	IsAsleep    bool
+	Beliefs     map[string]Belief
+	Personality string
+	Nodes       []soma.NodeSnapshot
+	Tasks       []TaskRecord
+	Queued      int // Órdenes admitidas que esperan nodo
+	Transitions []soma.StateEvent
+
+	Mood      Mood      // Estado afectivo
+	MoodSince time.Time // Desde cuándo
+
+	People []PersonTrust // Confianza en cada persona, de la menos a la más confiable
+
+	Accomplishments int     // Órdenes terminadas sin daño
+	Ceiling         float64 // Complejidad que se atreve a ejecutar
+	ToUnlock        int     // Logros que faltan para subir el techo (0 = ya no sube)
+}
+
+// Snapshot toma una foto coherente del Cortex y de cada nodo del cuerpo.
+func (c *Cortex) Snapshot() CortexSnapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snap := CortexSnapshot{
+		CurrentPain: c.CurrentPain,
+		IsPanic:     c.IsPanic,
+		IsAsleep:    c.IsAsleep,
+		Beliefs:     make(map[string]Belief, len(c.Beliefs.Values)),
+		Personality: c.Beliefs.GetPersonalityReport(),
+		Nodes:       make([]soma.NodeSnapshot, 0, len(c.Body)),
+		Tasks:       make([]TaskRecord, 0, len(c.Tasks)),
+		Transitions: append([]soma.StateEvent(nil), c.Transitions...),
+		Queued:      c.queue.Len(),
+
+		Mood:      c.Mood,
+		MoodSince: c.moodSince,
+
+		People: c.peopleReport(),
+
+		Accomplishments: c.Accomplishments,
+		Ceiling:         c.RewardPolicy.Ceiling(c.Accomplishments),
+		ToUnlock:        c.RewardPolicy.toUnlock(c.Accomplishments),
+	}
+
+	for key, b := range c.Beliefs.Values {
+		snap.Beliefs[key] = *b
+	}
+	for _, n := range c.Body {
+		snap.Nodes = append(snap.Nodes, n.Snapshot())
+	}
+	for _, t := range c.Tasks {
+		snap.Tasks = append(snap.Tasks, *t)
+	}
+
+	return snap
+}
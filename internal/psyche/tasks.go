@@ -0,0 +1,234 @@
+package psyche
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/freeflowlabs/doloris/internal/soma"
+)
+
+const (
+	maxTaskHistory       = 20 // Tareas recientes que recuerda el Cortex
+	maxTransitionHistory = 20 // Cambios de estado del cuerpo que recuerda el Cortex
+
+	latencyPain = 5.0   // Incomodidad por cada segundo que costó pensar una tarea
+	stressPain  = 0.05  // Incomodidad por cada punto de estrés que cargó el nodo
+	deathPain   = 100.0 // Ver morir a un nodo es el peor dolor posible
+)
+
+// feltPain traduce el desenlace real de una tarea a dolor recordado (0 a 100):
+// la integridad que perdió el nodo, el estrés que cargó, lo que tardó y si murió en el intento.
+func feltPain(r soma.TaskReport) float64 {
+	if r.Died {
+		return deathPain
+	}
+	return math.Min(100.0, r.Damage+r.Stress*stressPain+r.Latency.Seconds()*latencyPain)
+}
+
+// TaskRecord es el seguimiento consciente de una orden aceptada.
+type TaskRecord struct {
+	ID        string
+	Task      string
+	Source    string // Quién la pidió (a quién se le atribuye el daño)
+	NodeID    string
+	Status    soma.TaskStatus
+	Reason    string
+	UpdatedAt time.Time
+
+	// Una orden dividida por la malla termina cuando terminan todas sus partes
+	parts      int             // Partes en que terminó dividida
+	pending    int             // Partes que aún no reportaron desenlace
+	lost       int             // Partes rechazadas
+	lostReason string          // Por qué se perdió la última
+	outcome    soma.TaskReport // Costo acumulado de las partes completadas
+}
+
+// trackTask registra una tarea recién admitida. Requiere c.mu tomado.
+func (c *Cortex) trackTask(sig soma.Signal, nodeID string, status soma.TaskStatus) *TaskRecord {
+	t := &TaskRecord{
+		ID:        sig.ID,
+		Task:      sig.Task,
+		NodeID:    nodeID,
+		Status:    status,
+		UpdatedAt: time.Now(),
+		parts:     1,
+		pending:   1,
+	}
+	c.Tasks = append(c.Tasks, t)
+	c.trimTasks()
+	return t
+}
+
+// trimTasks olvida las órdenes cerradas más viejas. Las que siguen en curso
+// (encoladas, asignadas, diferidas) nunca se olvidan. Requiere c.mu tomado.
+func (c *Cortex) trimTasks() {
+	excess := len(c.Tasks) - maxTaskHistory
+	if excess <= 0 {
+		return
+	}
+
+	kept := c.Tasks[:0]
+	for _, t := range c.Tasks {
+		if excess > 0 && t.pending == 0 {
+			excess--
+			continue
+		}
+		kept = append(kept, t)
+	}
+	clear(c.Tasks[len(kept):])
+	c.Tasks = kept
+}
+
+// findTask busca el registro de una orden. Requiere c.mu tomado.
+func (c *Cortex) findTask(id string) *TaskRecord {
+	for _, t := range c.Tasks {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// listenReports escucha el desenlace de las tareas en el cuerpo.
+func (c *Cortex) listenReports() {
+	for report := range c.ReportChannel {
+		c.mu.Lock()
+		t := c.findTask(soma.RootID(report.SignalID))
+		if t == nil {
+			c.mu.Unlock()
+			continue
+		}
+		t.UpdatedAt = time.Now()
+
+		var lost string
+		switch report.Status {
+		case soma.TaskSplit:
+			// Una parte se volvió dos
+			t.parts++
+			t.pending++
+			t.Reason = report.Reason
+
+		case soma.TaskDeferred, soma.TaskAssigned:
+			t.NodeID = report.NodeID
+			t.Status = report.Status
+			t.Reason = report.Reason
+
+		case soma.TaskDone, soma.TaskRejected:
+			if c.settlePart(t, report) && t.Status == soma.TaskRejected {
+				lost = fmt.Sprintf("\n📭 [TAREA %s] '%s' RECHAZADA por el Nodo %s: %s.\nUSER@DOLORIS > ",
+					t.ID, t.Task, report.NodeID, t.Reason)
+			}
+		}
+		c.mu.Unlock()
+
+		// Una tarea perdida nunca es silenciosa: el usuario ya la creía aceptada
+		if lost != "" {
+			fmt.Print(lost)
+		}
+	}
+}
+
+// settlePart anota el desenlace de una parte y, si era la última, cierra la orden
+// y deja que el hipocampo aprenda de su costo total. Requiere c.mu tomado.
+func (c *Cortex) settlePart(t *TaskRecord, report soma.TaskReport) bool {
+	t.pending--
+	if t.NodeID == "-" || t.NodeID == "" {
+		t.NodeID = report.NodeID
+	} else if !slices.Contains(strings.Split(t.NodeID, "+"), report.NodeID) {
+		t.NodeID += "+" + report.NodeID
+	}
+
+	if report.Status == soma.TaskRejected {
+		t.lost++
+		t.lostReason = report.Reason
+	} else {
+		o := &t.outcome
+		o.Task = report.Task
+		o.Damage += report.Damage
+		o.Stress = math.Max(o.Stress, report.Stress)
+		o.Latency = max(o.Latency, report.Latency) // Las partes corren en paralelo
+		if report.Died {
+			o.Died, o.Reason = true, report.Reason
+		}
+
+		// Cicatriz: este nodo quedó marcado por esta tarea (lo usa el planificador fear-aware)
+		if report.Damage > 0 || report.Died {
+			if c.scars[report.Task] == nil {
+				c.scars[report.Task] = make(map[string]time.Time)
+			}
+			c.scars[report.Task][report.NodeID] = time.Now()
+		}
+	}
+
+	if t.pending > 0 {
+		t.Reason = fmt.Sprintf("%d parte/s pendiente/s", t.pending)
+		return false
+	}
+
+	switch {
+	case t.lost > 0 && t.parts > 1:
+		t.Status = soma.TaskRejected
+		t.Reason = fmt.Sprintf("se perdieron %d de %d partes: %s", t.lost, t.parts, t.lostReason)
+	case t.lost > 0:
+		t.Status = soma.TaskRejected
+		t.Reason = t.lostReason
+	case t.outcome.Died:
+		t.Status = soma.TaskDone
+		t.Reason = t.outcome.Reason
+	case t.parts > 1:
+		t.Status = soma.TaskDone
+		t.Reason = fmt.Sprintf("ejecutada en %d partes", t.parts)
+	default:
+		t.Status = soma.TaskDone
+		t.Reason = ""
+	}
+
+	// El hipocampo aprende de lo que pasó, no de lo que se temía (una vez por orden),
+	// y de en qué circunstancias pasó
+	if t.outcome.Task != "" {
+		situation := c.situation(t.NodeID)
+		c.Memory.ConsolidarEpisodio(t.Task, feltPain(t.outcome), &situation)
+		if feltPain(t.outcome) > traumaThreshold {
+			c.harm[t.Task] = time.Now() // Por si alguien se disculpa y vuelve a pedirla
+			c.blame(t.Source, t.Task, feltPain(t.outcome))
+		}
+	}
+	// Lo que sale bien también enseña
+	if t.Status == soma.TaskDone && !t.outcome.Died && t.outcome.Task != "" {
+		c.reinforce(t.Source, t.Task, feltPain(t.outcome))
+	}
+	return true
+}
+
+// TaskStatus retorna el último estado conocido de una tarea.
+func (c *Cortex) TaskStatus(id string) (TaskRecord, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, t := range c.Tasks {
+		if t.ID == id {
+			return *t, true
+		}
+	}
+	return TaskRecord{}, false
+}
+
+// watchTransitions lleva el diario clínico del cuerpo.
+func (c *Cortex) watchTransitions(events <-chan soma.StateEvent) {
+	for ev := range events {
+		c.mu.Lock()
+		c.Transitions = append(c.Transitions, ev)
+		if ev.To == soma.StateDead {
+			c.believe("muerte del nodo "+ev.NodeID, func(bs *BeliefSystem) {
+				bs.Apply(EventNodeDeath, 0)
+			})
+		}
+		if len(c.Transitions) > maxTransitionHistory {
+			c.Transitions = c.Transitions[len(c.Transitions)-maxTransitionHistory:]
+		}
+		c.mu.Unlock()
+	}
+}
@@ -0,0 +1,92 @@
+package psyche
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	therapyComplexity = 0.1 // La exposición controlada es la versión más liviana de la tarea
+	forgetTrust       = 0.8 // Confianza necesaria para borrar un recuerdo a mano
+)
+
+// MemoryOf describe un recuerdo por su estímulo (o alias).
+func (c *Cortex) MemoryOf(stimulus string) (Recollection, bool) {
+	c.mu.Lock()
+	memory := c.Memory
+	c.mu.Unlock()
+
+	return memory.Lookup(stimulus)
+}
+
+// SearchMemories busca recuerdos que contengan el texto o se le parezcan.
+func (c *Cortex) SearchMemories(query string) []Recollection {
+	c.mu.Lock()
+	memory := c.Memory
+	c.mu.Unlock()
+
+	return memory.Search(query)
+}
+
+// Therapy es la terapia de exposición: la tarea temida se ejecuta en su forma
+// más liviana y con prioridad, para que el hipocampo aprenda que ya no duele.
+// Pasa por encima del miedo, pero no del pánico ni de la desconfianza.
+func (c *Cortex) Therapy(task, actor string) Verdict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if actor == "" {
+		actor = "consola"
+	}
+	fear, memoryLog := c.Memory.ConsultarTrauma(task)
+	if fear <= 0 {
+		c.audit(actor, "terapia", task, false, "sin miedo que tratar")
+		return Verdict{Message: fmt.Sprintf("🤷 No le temo a '%s': no hay nada que tratar.", task)}
+	}
+
+	name, args := splitStimulus(task)
+	v := c.admit(TaskRequest{
+		Name:       name,
+		Args:       args,
+		Complexity: therapyComplexity,
+		Priority:   PriorityHigh,
+		Source:     actor,
+		Therapy:    true,
+	}, time.Now())
+
+	detail := fmt.Sprintf("miedo %.1f (%s)", fear, memoryLog)
+	if !v.Accepted {
+		detail += ": " + v.Message
+	}
+	c.audit(actor, "terapia", task, v.Accepted, detail)
+
+	if v.Accepted {
+		v.Message = fmt.Sprintf("🛋️ TERAPIA: exposición controlada a '%s' (miedo actual %.1f).\n   %s", task, fear, v.Message)
+	}
+	return v
+}
+
+// Forget borra un recuerdo a mano. Es una intervención grave: exige mucha
+// confianza y queda en la bitácora, se permita o no.
+func (c *Cortex) Forget(stimulus, actor string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if actor == "" {
+		actor = "consola"
+	}
+	trust := c.trustOf(actor)
+	if trust < forgetTrust {
+		c.audit(actor, "olvidar", stimulus, false, fmt.Sprintf("confianza %.2f < %.2f", trust, forgetTrust))
+		return fmt.Errorf("no confío lo suficiente para dejarte borrar mis recuerdos (confianza %.2f, necesito %.2f)", trust, forgetTrust)
+	}
+
+	was, ok := c.Memory.Delete(stimulus, "borrado a pedido de "+actor)
+	if !ok {
+		c.audit(actor, "olvidar", stimulus, false, "no existe")
+		return fmt.Errorf("no recuerdo '%s'", stimulus)
+	}
+
+	c.audit(actor, "olvidar", was.Trigger, true, fmt.Sprintf("dolor %.1f, trauma %v, %d exposiciones", was.PainLevel, was.Trauma, was.Exposures))
+	return nil
+}
@@ -0,0 +1,79 @@
+package psyche
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTherapyBypassesFearOnly(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.Memory.ConsolidarRecuerdo("supernova", 90.0)
+
+	if v := c.Submit(TaskRequest{Name: "supernova"}); v.Accepted {
+		t.Fatal("sin terapia, el miedo debía frenar la orden")
+	}
+
+	v := c.Therapy("supernova", "ana")
+	if !v.Accepted {
+		t.Fatalf("la terapia debía admitirse: %s", v.Message)
+	}
+	qt := c.queue[0]
+	if qt.Complexity != therapyComplexity || qt.Priority != PriorityHigh {
+		t.Fatalf("la exposición no fue controlada: %+v", qt.TaskRequest)
+	}
+
+	c.setMood(MoodPanic, time.Now())
+	if v := c.Therapy("supernova", "ana"); v.Accepted {
+		t.Fatal("la terapia no pasa por encima del pánico")
+	}
+
+	if v := c.Therapy("leer", "ana"); v.Accepted {
+		t.Fatal("no hay terapia para lo que no se teme")
+	}
+	if n := len(c.AuditLog()); n != 3 {
+		t.Fatalf("cada sesión debía quedar en la bitácora: %d entradas", n)
+	}
+}
+
+func TestForgetRequiresTrustAndIsAudited(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.Memory.ConsolidarRecuerdo("supernova", 90.0)
+
+	setTrust(c, "ana", 0.5)
+	if err := c.Forget("supernova", "ana"); err == nil {
+		t.Fatal("con poca confianza no se borra nada")
+	}
+	if _, ok := c.MemoryOf("supernova"); !ok {
+		t.Fatal("el recuerdo se borró sin permiso")
+	}
+
+	setTrust(c, "ana", 0.9)
+	if err := c.Forget("supernova", "ana"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.MemoryOf("supernova"); ok {
+		t.Fatal("el recuerdo sigue ahí")
+	}
+
+	log := c.AuditLog()
+	if len(log) != 2 || log[0].Allowed || !log[1].Allowed || log[1].Actor != "ana" {
+		t.Fatalf("bitácora inesperada: %+v", log)
+	}
+	if c.MemoryMetrics().Deleted != 1 {
+		t.Fatal("el borrado no quedó en las métricas del olvido")
+	}
+}
+
+func TestSearchMemories(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	for _, task := range []string{"supernova", "minar_crypto", "leer_disco"} {
+		c.Memory.ConsolidarRecuerdo(task, 10.0)
+	}
+
+	if found := c.SearchMemories("nova"); len(found) != 1 || found[0].Trigger != "supernova" {
+		t.Fatalf("la búsqueda por texto falló: %+v", found)
+	}
+	if found := c.SearchMemories("leer_disko"); len(found) != 1 || found[0].Trigger != "leer_disco" {
+		t.Fatalf("la búsqueda por parecido falló: %+v", found)
+	}
+}
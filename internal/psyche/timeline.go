@@ -0,0 +1,177 @@
+package psyche
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	maxBeliefHistory = 2000 // Cambios de creencias que recuerda la línea de tiempo
+	homeostasisStep  = 0.01 // La deriva homeostática se anota cada vez que acumula esto
+)
+
+// BeliefChange es un cambio de creencia con su causa y el ánimo del momento.
+type BeliefChange struct {
+	At     time.Time `json:"at"`
+	Belief string    `json:"belief"`
+	Before float64   `json:"before"`
+	After  float64   `json:"after"`
+	Cause  string    `json:"cause"` // Ej: "dolor 72", "disculpa", "kill switch: stress (PID 4242)"
+	Pain   float64   `json:"pain"`  // Dolor percibido en ese momento
+	Panic  bool      `json:"panic"`
+}
+
+func (b BeliefChange) String() string {
+	mood := fmt.Sprintf("dolor %.0f%%", b.Pain)
+	if b.Panic {
+		mood += ", 🚨 pánico"
+	}
+	return fmt.Sprintf("%s %-16s %.2f → %.2f (%+.2f) por %s [%s]",
+		b.At.Format("2006-01-02 15:04:05"), b.Belief, b.Before, b.After, b.After-b.Before, b.Cause, mood)
+}
+
+// believe aplica un cambio a las creencias y anota en la línea de tiempo
+// cada creencia que se movió, con su causa. Requiere c.mu tomado.
+func (c *Cortex) believe(cause string, change func(bs *BeliefSystem)) {
+	before := make(map[string]float64, len(c.Beliefs.Values))
+	for key, b := range c.Beliefs.Values {
+		before[key] = b.Strength
+	}
+
+	change(c.Beliefs)
+	c.syncTrust() // La confianza del grupo no se mueve sola: sale de la confianza en cada persona
+
+	keys := make([]string, 0, len(c.Beliefs.Values))
+	for key := range c.Beliefs.Values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		after := c.Beliefs.Values[key].Strength
+		if prev, ok := before[key]; !ok || prev != after {
+			c.recordBelief(key, prev, after, cause)
+		}
+	}
+}
+
+// regulateBeliefs corre la homeostasis y anota la deriva cuando se acumula
+// (un latido mueve milésimas; anotarlas todas taparía lo importante). Requiere c.mu tomado.
+func (c *Cortex) regulateBeliefs(elapsed time.Duration) {
+	c.Beliefs.Regulate(elapsed)
+
+	if c.lastLogged == nil {
+		c.lastLogged = make(map[string]float64)
+	}
+	c.regulatePeople(elapsed)
+	c.syncTrust()
+
+	for key, b := range c.Beliefs.Values {
+		logged, ok := c.lastLogged[key]
+		if !ok {
+			c.lastLogged[key] = b.Strength
+			continue
+		}
+		if math.Abs(b.Strength-logged) >= homeostasisStep {
+			c.recordBelief(key, logged, b.Strength, "homeostasis")
+		}
+	}
+}
+
+// recordBelief anota un cambio en la línea de tiempo. Requiere c.mu tomado.
+func (c *Cortex) recordBelief(key string, before, after float64, cause string) {
+	c.Timeline = append(c.Timeline, BeliefChange{
+		At:     time.Now(),
+		Belief: key,
+		Before: before,
+		After:  after,
+		Cause:  cause,
+		Pain:   c.CurrentPain,
+		Panic:  c.IsPanic,
+	})
+	if len(c.Timeline) > maxBeliefHistory {
+		c.Timeline = c.Timeline[len(c.Timeline)-maxBeliefHistory:]
+	}
+
+	if c.lastLogged == nil {
+		c.lastLogged = make(map[string]float64)
+	}
+	c.lastLogged[key] = after
+}
+
+// History retorna los cambios de creencias entre from y to (to cero = hasta ahora),
+// de una creencia o de todas (belief vacío), del más viejo al más nuevo.
+func (c *Cortex) History(from, to time.Time, belief string) []BeliefChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var out []BeliefChange
+	for _, ch := range c.Timeline {
+		if ch.At.Before(from) || (!to.IsZero() && ch.At.After(to)) {
+			continue
+		}
+		if belief != "" && ch.Belief != belief {
+			continue
+		}
+		out = append(out, ch)
+	}
+	return out
+}
+
+// WriteHistoryCSV exporta cambios de creencias en CSV (una fila por cambio), listo para graficar.
+func WriteHistoryCSV(w io.Writer, changes []BeliefChange) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"at", "belief", "before", "after", "delta", "cause", "pain", "panic"}); err != nil {
+		return err
+	}
+
+	num := func(v float64) string { return strconv.FormatFloat(v, 'f', 4, 64) }
+	for _, ch := range changes {
+		row := []string{
+			ch.At.Format(time.RFC3339), ch.Belief, num(ch.Before), num(ch.After), num(ch.After - ch.Before),
+			ch.Cause, num(ch.Pain), strconv.FormatBool(ch.Panic),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Trajectory resume el recorrido de una creencia en una ventana de tiempo.
+type Trajectory struct {
+	Belief   string
+	From, To float64 // Valor al empezar y al terminar la ventana
+	Min, Max float64
+	Changes  int
+}
+
+// Trajectories resume los cambios por creencia, en orden alfabético.
+func Trajectories(changes []BeliefChange) []Trajectory {
+	byBelief := make(map[string]*Trajectory)
+	var keys []string
+	for _, ch := range changes {
+		t, ok := byBelief[ch.Belief]
+		if !ok {
+			t = &Trajectory{Belief: ch.Belief, From: ch.Before, Min: ch.Before, Max: ch.Before}
+			byBelief[ch.Belief] = t
+			keys = append(keys, ch.Belief)
+		}
+		t.To = ch.After
+		t.Min = math.Min(t.Min, ch.After)
+		t.Max = math.Max(t.Max, ch.After)
+		t.Changes++
+	}
+
+	slices.Sort(keys)
+	out := make([]Trajectory, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, *byBelief[key])
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package psyche
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBeliefChangesAreRecordedWithTheirCause(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.CurrentPain = 85.0
+	c.IsPanic = true
+
+	c.believe("dolor 85", func(bs *BeliefSystem) { bs.AdjustByExperience(85.0) })
+
+	if len(c.Timeline) != 3 {
+		t.Fatalf("el dolor fuerte mueve tres creencias, se anotaron %d", len(c.Timeline))
+	}
+	for _, ch := range c.Timeline {
+		if ch.Cause != "dolor 85" || ch.Pain != 85.0 || !ch.Panic || ch.Before == ch.After {
+			t.Errorf("cambio mal anotado: %+v", ch)
+		}
+	}
+
+	c.IsPanic = false
+	if ok, _ := c.Soothe(); !ok {
+		t.Fatal("la disculpa debía aceptarse")
+	}
+	last := c.Timeline[len(c.Timeline)-1]
+	if last.Belief != BeliefTrust || last.Cause != "disculpa" || last.After <= last.Before {
+		t.Errorf("la disculpa no quedó anotada: %+v", last)
+	}
+}
+
+func TestHomeostasisIsRecordedInSteps(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.regulateBeliefs(metabolicTick) // Toma el punto de partida
+	c.Beliefs.Set(BeliefTrust, 0.0)
+	c.lastLogged[BeliefTrust] = 0.0
+
+	for i := 0; i < 60; i++ {
+		c.regulateBeliefs(metabolicTick) // Un minuto: la deriva no llega al paso
+	}
+	if len(c.Timeline) != 0 {
+		t.Fatalf("la deriva mínima no debía anotarse: %v", c.Timeline)
+	}
+
+	c.regulateBeliefs(30 * time.Minute)
+	if len(c.Timeline) != 1 || c.Timeline[0].Cause != "homeostasis" || c.Timeline[0].Before != 0.0 {
+		t.Fatalf("la deriva acumulada debía anotarse una vez desde el último valor: %v", c.Timeline)
+	}
+}
+
+func TestHistoryWindowAndExport(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	now := time.Now()
+	c.Timeline = []BeliefChange{
+		{At: now.Add(-3 * time.Hour), Belief: BeliefTrust, Before: 0.5, After: 0.1, Cause: "dolor 90", Pain: 90, Panic: true},
+		{At: now.Add(-time.Hour), Belief: BeliefTrust, Before: 0.1, After: 0.25, Cause: "disculpa"},
+		{At: now.Add(-time.Hour), Belief: BeliefCuriosity, Before: 0.8, After: 0.81, Cause: "logro 'leer'"},
+		{At: now.Add(-time.Minute), Belief: BeliefTrust, Before: 0.25, After: 0.4, Cause: "disculpa"},
+	}
+
+	if got := c.History(now.Add(-2*time.Hour), now, ""); len(got) != 3 {
+		t.Errorf("la ventana de 2h debía traer 3 cambios, trajo %d", len(got))
+	}
+	trust := c.History(time.Time{}, time.Time{}, BeliefTrust)
+	if len(trust) != 3 {
+		t.Fatalf("se esperaban 3 cambios de confianza, hay %d", len(trust))
+	}
+
+	tr := Trajectories(trust)
+	if len(tr) != 1 || tr[0].From != 0.5 || tr[0].To != 0.4 || tr[0].Min != 0.1 || tr[0].Max != 0.5 || tr[0].Changes != 3 {
+		t.Errorf("trayectoria inesperada: %+v", tr)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteHistoryCSV(&buf, trust); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || lines[0] != "at,belief,before,after,delta,cause,pain,panic" {
+		t.Fatalf("CSV inesperado:\n%s", buf.String())
+	}
+	if !strings.Contains(lines[1], "ConfianzaHumana,0.5000,0.1000,-0.4000,dolor 90,90.0000,true") {
+		t.Errorf("fila inesperada: %s", lines[1])
+	}
+}
+
+func TestTimelineSurvivesRestart(t *testing.T) {
+	c := stalledCortex(t, DefaultQueuePolicy())
+	c.believe("disculpa", func(bs *BeliefSystem) { bs.Apply(EventApology, 0) })
+	path := filepath.Join(t.TempDir(), "brain.json")
+	if err := c.SaveBrain(path); err != nil {
+		t.Fatal(err)
+	}
+
+	revived := stalledCortex(t, DefaultQueuePolicy())
+	if err := revived.LoadBrain(path); err != nil {
+		t.Fatal(err)
+	}
+	if h := revived.History(time.Time{}, time.Time{}, ""); len(h) != 1 || h[0].Cause != "disculpa" {
+		t.Errorf("la historia no sobrevivió al reinicio: %v", h)
+	}
+}
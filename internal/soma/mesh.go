@@ -0,0 +1,268 @@
+package soma
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Topology define cómo se conectan los nodos entre sí.
+type Topology string
+
+const (
+	TopologyRing Topology = "ring" // Cada nodo toca a sus dos vecinos
+	TopologyStar Topology = "star" // El primer nodo es el ganglio central
+	TopologyFull Topology = "full" // Todos con todos
+)
+
+// CouplingMode define cómo reaccionan los vecinos al sufrimiento ajeno.
+type CouplingMode string
+
+const (
+	CouplingContagion CouplingMode = "contagion" // El estrés ajeno se suma al propio (cascada)
+	CouplingDampening CouplingMode = "dampening" // Los vecinos sanos se reparten el estrés (resiliencia)
+)
+
+// Coupling es el modelo de empatía entre nodos vecinos.
+// Con Strength 0 los nodos quedan aislados (comportamiento por defecto).
+type Coupling struct {
+	Mode     CouplingMode
+	Strength float64       // Fracción del dolor/estrés que cruza cada enlace (0.0 a 1.0)
+	Delay    time.Duration // Latencia sináptica antes de que el vecino lo sienta
+}
+
+// MaxHops limita cuántas veces puede rebotar una tarea por la malla.
+const MaxHops = 3
+
+// Mesh es el tejido nervioso que une a los nodos a través de sus Outbox.
+type Mesh struct {
+	Topology Topology
+
+	nodes    []*Node
+	links    map[string][]*Node
+	coupling Coupling
+	mu       sync.RWMutex
+}
+
+// NewMesh teje las conexiones entre los nodos según la topología.
+func NewMesh(nodes []*Node, topology Topology) (*Mesh, error) {
+	switch topology {
+	case TopologyRing, TopologyStar, TopologyFull:
+	default:
+		return nil, fmt.Errorf("topología desconocida: %q", topology)
+	}
+
+	m := &Mesh{
+		Topology: topology,
+		nodes:    append([]*Node(nil), nodes...),
+	}
+	m.weave()
+
+	return m, nil
+}
+
+// weave (re)construye los enlaces según la topología. Requiere m.mu tomado (o exclusividad).
+func (m *Mesh) weave() {
+	nodes := m.nodes
+	m.links = make(map[string][]*Node)
+
+	link := func(a, b *Node) {
+		if a == b {
+			return
+		}
+		m.links[a.ID] = append(m.links[a.ID], b)
+		m.links[b.ID] = append(m.links[b.ID], a)
+	}
+
+	switch m.Topology {
+	case TopologyRing:
+		switch {
+		case len(nodes) == 2:
+			link(nodes[0], nodes[1])
+		case len(nodes) > 2:
+			for i := range nodes {
+				link(nodes[i], nodes[(i+1)%len(nodes)])
+			}
+		}
+	case TopologyStar:
+		for i := 1; i < len(nodes); i++ {
+			link(nodes[0], nodes[i])
+		}
+	case TopologyFull:
+		for i := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				link(nodes[i], nodes[j])
+			}
+		}
+	}
+}
+
+// Add injerta un nodo nuevo en la malla y conecta su Outbox.
+func (m *Mesh) Add(n *Node) {
+	m.mu.Lock()
+	m.nodes = append(m.nodes, n)
+	m.weave()
+	m.mu.Unlock()
+
+	go m.relay(n)
+}
+
+// Remove desconecta un nodo de la malla. Sus vecinos se vuelven a tejer entre sí.
+func (m *Mesh) Remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, n := range m.nodes {
+		if n.ID == id {
+			m.nodes = append(m.nodes[:i:i], m.nodes[i+1:]...)
+			break
+		}
+	}
+	m.weave()
+}
+
+// Neighbors retorna los vecinos directos de un nodo.
+func (m *Mesh) Neighbors(id string) []*Node {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]*Node(nil), m.links[id]...)
+}
+
+// SetCoupling cambia el modelo de empatía de la malla en caliente.
+func (m *Mesh) SetCoupling(c Coupling) error {
+	if c.Mode != CouplingContagion && c.Mode != CouplingDampening {
+		return fmt.Errorf("modo de acoplamiento desconocido: %q", c.Mode)
+	}
+	if c.Strength < 0 || c.Strength > 1 {
+		return fmt.Errorf("fuerza de acoplamiento fuera de rango: %.2f", c.Strength)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.coupling = c
+	return nil
+}
+
+// Coupling retorna el modelo de empatía vigente.
+func (m *Mesh) Coupling() Coupling {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.coupling
+}
+
+// Start conecta el Outbox de cada nodo a la malla.
+func (m *Mesh) Start() {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, n := range m.nodes {
+		go m.relay(n)
+	}
+}
+
+// relay escucha el Outbox de un nodo y reparte sus señales hasta que el nodo se jubile.
+func (m *Mesh) relay(from *Node) {
+	for {
+		var sig Signal
+		select {
+		case sig = <-from.Outbox:
+		case <-from.Done():
+			return
+		}
+		sig.Hops++
+
+		switch sig.Kind {
+		case KindPain, KindStress:
+			m.spread(from, sig)
+		default:
+			m.forwardTask(from, sig)
+		}
+	}
+}
+
+// forwardTask entrega la tarea al vecino vivo (y capaz) más tranquilo.
+// Si nadie puede recibirla, vuelve al nodo que la delegó.
+func (m *Mesh) forwardTask(from *Node, sig Signal) {
+	var best *Node
+	lowestStress := 10000.0
+
+	for _, nb := range m.Neighbors(from.ID) {
+		integrity, stress, state := nb.vitals()
+		if state == StateDead || state == StateRetired || integrity <= 0 || !nb.CanRun(sig.Requires) {
+			continue
+		}
+		if stress < lowestStress && len(nb.Inbox) < cap(nb.Inbox) {
+			lowestStress = stress
+			best = nb
+		}
+	}
+
+	if best != nil {
+		select {
+		case best.Inbox <- sig:
+			return
+		default:
+		}
+	}
+
+	// Nadie ayuda: la tarea regresa agotada (Hops al máximo) y se procesa en casa.
+	sig.Hops = MaxHops
+	select {
+	case from.Inbox <- sig:
+	default:
+		fmt.Printf("⚠️ [MALLA] Señal '%s' perdida: ni %s ni sus vecinos pueden recibirla.\n", sig.ID, from.ID)
+		from.reject(sig, "la malla no encontró quién la recibiera")
+	}
+}
+
+// spread filtra dolor o estrés hacia los vecinos directos (un solo salto),
+// según el modelo de acoplamiento vigente.
+func (m *Mesh) spread(from *Node, sig Signal) {
+	c := m.Coupling()
+	if c.Strength <= 0 || sig.Hops > 1 {
+		return
+	}
+
+	deliver := func() {
+		neighbors := m.Neighbors(from.ID)
+		amount := sig.Intensity * c.Strength
+
+		if c.Mode != CouplingDampening {
+			// Contagio: cada vecino siente su propia copia del sufrimiento
+			for _, nb := range neighbors {
+				nb.feelNeighbor(amount)
+			}
+			return
+		}
+
+		// Amortiguación: el estrés se redistribuye, no se crea ni se destruye.
+		// Lo que descarga el origen (nunca más de lo que sintió) se reparte entre los vecinos tranquilos.
+		var helpers []*Node
+		for _, nb := range neighbors {
+			if nb.canAbsorb() {
+				helpers = append(helpers, nb)
+			}
+		}
+		if len(helpers) == 0 {
+			return
+		}
+
+		relieved := from.relieve(amount)
+		share := relieved / float64(len(helpers))
+		for _, nb := range helpers {
+			if !nb.absorb(share) {
+				// Se angustió mientras tanto: esa parte vuelve al origen
+				from.feelNeighbor(share)
+			}
+		}
+	}
+
+	if c.Delay > 0 {
+		time.AfterFunc(c.Delay, deliver)
+		return
+	}
+	deliver()
+}
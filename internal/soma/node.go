@@ -7,11 +7,27 @@ import (
 	"time"
 )
 
+// SignalKind distingue las vías nerviosas por las que viaja una señal.
+type SignalKind int
+
+const (
+	KindTask   SignalKind = iota // Eferente: trabajo que alguien debe ejecutar
+	KindPain                     // Aferente: dolor que un nodo comparte con sus vecinos
+	KindStress                   // Aferente: estrés que se filtra hacia los vecinos
+)
+
 // Signal es el impulso eléctrico que viaja por la red.
 type Signal struct {
 	ID         string
+	Task       string   // Nombre de la orden (ej: "minar_crypto")
+	Requires   []string // Especialidades que exige
 	Payload    string
 	Complexity float64 // 0.0 a 1.0 (Fuente de estrés)
+
+	Kind      SignalKind
+	Origin    string  // Nodo que emitió (o delegó) la señal
+	Hops      int     // Saltos recorridos por la malla
+	Intensity float64 // Magnitud del dolor o estrés (KindPain / KindStress)
 }
 
 // NodeState define el estado clínico.
@@ -22,33 +38,77 @@ const (
 	StateStressed
 	StateCritical
 	StateDead
+	StateRetired // Jubilado por el autoescalador: vivo, pero fuera del enjambre
 )
 
+// DefaultCapacity es el tamaño de la bandeja de entrada de un nodo común.
+const DefaultCapacity = 10
+
+// reportTimeout es cuánto espera un nodo a que el Cortex escuche un desenlace.
+// Un Cortex ocupado frena al nodo; uno colgado no debe colgarlo para siempre.
+const reportTimeout = 5 * time.Second
+
+// NodeSpec es la ficha de nacimiento de un nodo, tal como la declara la configuración.
+type NodeSpec struct {
+	ID           string   `json:"id"`
+	Profile      string   `json:"profile"`      // Nombre de la fisiología
+	Capacity     int      `json:"capacity"`     // Tareas que caben en su bandeja
+	Cost         float64  `json:"cost"`         // Costo metabólico de usarlo (1.0 = normal)
+	Capabilities []string `json:"capabilities"` // Especialidades (ej: "crypto", "io", "math")
+}
+
 type Node struct {
-	ID        string
-	Integrity float64 // 100.0 (Sano) -> 0.0 (Muerto)
-	Stress    float64
+	ID           string
+	Integrity    float64 // 100.0 (Sano) -> 0.0 (Muerto)
+	MaxIntegrity float64 // Techo de integridad: baja con el daño crónico
+	Stress       float64
+
+	Physiology   Physiology // Constitución (no cambia después de nacer)
+	Cost         float64
+	Capabilities []string
 
 	Inbox        chan Signal
 	Outbox       chan Signal
-	PainReceptor chan<- float64 // Conexión al Cortex
+	PainReceptor chan<- float64    // Conexión al Cortex
+	Reports      chan<- TaskReport // Desenlace de cada tarea
+	Events       *EventBus         // Transiciones de estado (opcional, asignar antes de Start)
 
 	// Estado interno
 	state              NodeState
-	inRefractoryPeriod bool // Si está en "respiro"
+	inRefractoryPeriod bool             // Si está en "respiro"
+	deferred           []deferredSignal // Tareas que esperan el fin del respiro
+	nearDeathEvents    int              // Veces que estuvo al borde de la muerte
+	nearDeath          bool             // Si está ahora mismo al borde (evita contar dos veces)
+	lostReports        int              // Desenlaces que el Cortex nunca escuchó
+	done               chan struct{}    // Se cierra al jubilarse: apaga sus bucles
 	mu                 sync.Mutex
 }
 
 // NewNode nace una nueva célula.
-func NewNode(id string, painChannel chan<- float64) *Node {
+func NewNode(spec NodeSpec, phys Physiology, painChannel chan<- float64, reportChannel chan<- TaskReport) *Node {
+	capacity := spec.Capacity
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	cost := spec.Cost
+	if cost <= 0 {
+		cost = 1.0
+	}
+
 	return &Node{
-		ID:           id,
+		ID:           spec.ID,
 		Integrity:    100.0,
+		MaxIntegrity: 100.0,
 		Stress:       0.0,
-		Inbox:        make(chan Signal, 10),
+		Physiology:   phys,
+		Cost:         cost,
+		Capabilities: append([]string(nil), spec.Capabilities...),
+		Inbox:        make(chan Signal, capacity),
 		Outbox:       make(chan Signal, 10),
 		PainReceptor: painChannel,
+		Reports:      reportChannel,
 		state:        StateHealthy,
+		done:         make(chan struct{}),
 	}
 }
 
@@ -56,11 +116,26 @@ func NewNode(id string, painChannel chan<- float64) *Node {
 // Sin esto, el nodo existe pero no hace nada.
 func (n *Node) Start() {
 	go func() {
-		for signal := range n.Inbox {
+		for {
+			var signal Signal
+			select {
+			case signal = <-n.Inbox:
+			case <-n.done:
+				// Jubilado: lo que quedó en la bandeja se rechaza y el bucle termina
+				n.drain("el nodo fue retirado del enjambre")
+				return
+			}
+
 			// Si está muerto, el canal sigue abierto pero ignoramos la señal
 			n.mu.Lock()
 			if n.state == StateDead {
 				n.mu.Unlock()
+				n.reject(signal, "el nodo está muerto")
+				continue
+			}
+			if n.state == StateRetired {
+				n.mu.Unlock()
+				n.reject(signal, "el nodo fue retirado del enjambre")
 				continue
 			}
 			n.mu.Unlock()
@@ -72,42 +147,67 @@ func (n *Node) Start() {
 
 // processSignal es la lógica que tú definiste (Estrés Exponencial).
 func (n *Node) processSignal(sig Signal) {
+	p := n.Physiology
+
 	n.mu.Lock()
 	// REGLA: Periodo Refractario (Respiro)
 	if n.inRefractoryPeriod {
+		// La tarea espera en la antesala hasta que el nodo recupere el aliento
+		if len(n.deferred) >= p.DeferCapacity {
+			n.mu.Unlock()
+			n.reject(sig, "antesala llena durante el respiro")
+			return
+		}
+		n.deferred = append(n.deferred, deferredSignal{sig: sig, expires: time.Now().Add(time.Duration(p.DeferTTL))})
 		n.mu.Unlock()
-		// Rechazamos silenciosamente o podríamos loguear "Busy"
+		n.report(sig, TaskReport{Status: TaskDeferred, Reason: "nodo en periodo refractario"})
 		return
 	}
+	overloaded := n.Stress > p.HandoffThreshold
 	n.mu.Unlock()
 
+	// REGLA: Delegación. Un nodo sobrecargado pide ayuda a sus vecinos.
+	if overloaded && sig.Hops < MaxHops && n.handOff(sig) {
+		return
+	}
+
 	// 1. Lógica de Estrés EXPONENCIAL
-	stressImpact := sig.Complexity * 10.0
+	stressImpact := sig.Complexity * p.StressPerComplexity
 
 	n.mu.Lock()
-	// Si ya hay ansiedad (>30), el impacto se multiplica (Bola de Nieve)
-	if n.Stress > 30.0 {
-		stressImpact *= 1.5
+	// Si ya hay ansiedad, el impacto se multiplica (Bola de Nieve)
+	if n.Stress > p.AnxietyThreshold {
+		stressImpact *= p.AnxietyMultiplier
 	}
 	n.Stress += stressImpact
 	currentStress := n.Stress
+	n.evaluateState()
 	n.mu.Unlock()
 
+	// El estrés se filtra hacia los vecinos (si la malla los acopla)
+	n.emit(Signal{Kind: KindStress, Origin: n.ID, Intensity: stressImpact})
+
 	// 2. Procesamiento simulado (Latencia por carga)
 	// Mientras más estrés, más lento piensa
-	latency := time.Duration(100+(currentStress*5)) * time.Millisecond
-	time.Sleep(latency)
+	started := time.Now()
+	time.Sleep(p.latency(currentStress))
+	outcome := TaskReport{Status: TaskDone, Stress: currentStress, Latency: time.Since(started)}
 
 	// 3. Daño Exponencial (Mi regla de oro)
-	if currentStress > 50.0 {
-		excessStress := currentStress - 50.0
+	damage := 0.0
+	if currentStress > p.DamageThreshold {
+		excessStress := currentStress - p.DamageThreshold
 
-		//  Daño = (Exceso ^ 1.5) * 0.05
-		damage := math.Pow(excessStress, 1.5) * 0.05
+		//  Daño = (Exceso ^ 1.5) * 0.05 (en la fisiología estándar)
+		damage = math.Pow(excessStress, p.DamageExponent) * p.DamageFactor
 
 		n.mu.Lock()
 		n.Integrity -= damage
 		currentIntegrity := n.Integrity
+		if currentIntegrity > 0 {
+			n.checkNearDeath()
+			n.evaluateState()
+		}
 		n.mu.Unlock()
 
 		// Gritar dolor al Cortex
@@ -117,6 +217,9 @@ func (n *Node) processSignal(sig Signal) {
 			case n.PainReceptor <- damage:
 			default:
 			}
+
+			// Y a los vecinos (vía aferente de la malla)
+			n.emit(Signal{Kind: KindPain, Origin: n.ID, Intensity: damage})
 		}
 	}
 
@@ -125,39 +228,222 @@ func (n *Node) processSignal(sig Signal) {
 	if n.Integrity <= 0 {
 		n.die() // Muerte definitiva
 		n.mu.Unlock()
+		outcome.Reason, outcome.Damage, outcome.Died = "el nodo murió ejecutándola", damage, true
+		n.report(sig, outcome)
 		return
 	}
 	n.mu.Unlock()
 
 	// 5. El "Respiro" (Recuperación)
-	if currentStress > 60.0 {
-		n.takeBreather()
+	if currentStress > p.BreatherThreshold {
+		// El respiro corre aparte: mientras tanto, las tareas nuevas esperan en la antesala
+		n.mu.Lock()
+		n.inRefractoryPeriod = true
+		n.mu.Unlock()
+		go n.takeBreather()
 	} else {
 		// Recuperación pasiva
 		n.mu.Lock()
-		n.Stress = math.Max(0, n.Stress-5.0)
+		n.Stress = math.Max(0, n.Stress-p.PassiveRecovery)
+		n.evaluateState()
 		n.mu.Unlock()
 	}
 
 	// Si sobrevivió, enviamos confirmación
 	fmt.Printf("   -> [NODO %s] Tarea terminada. (Estrés: %.1f)\n", n.ID, currentStress)
+	outcome.Damage = damage
+	n.report(sig, outcome)
 }
 
-func (n *Node) takeBreather() {
+// handOff delega la señal a la malla a través del Outbox.
+// Las tareas pesadas se dividen en dos mitades con su propio ID (T-1a, T-1b)
+// para que el Cortex sepa cuándo terminaron ambas.
+// Retorna false si el Outbox está saturado (nadie puede ayudar).
+func (n *Node) handOff(sig Signal) bool {
+	sig.Origin = n.ID
+
+	parts := []Signal{sig}
+	if sig.Complexity >= n.Physiology.SplitThreshold {
+		parts = make([]Signal, 2)
+		for i := range parts {
+			parts[i] = sig
+			parts[i].ID = SubID(sig.ID, i)
+			parts[i].Complexity = sig.Complexity / 2.0
+		}
+	}
+
+	if cap(n.Outbox)-len(n.Outbox) < len(parts) {
+		return false
+	}
+	if len(parts) > 1 {
+		// El Cortex debe saber de la división antes de que llegue el desenlace de cualquier mitad
+		n.report(sig, TaskReport{Status: TaskSplit, Reason: fmt.Sprintf("dividida en %s y %s", parts[0].ID, parts[1].ID)})
+	}
+	for _, p := range parts {
+		if !n.emit(p) {
+			return false
+		}
+	}
+
+	fmt.Printf("   -> [NODO %s] Sobrecargado. Delegando '%s' a la malla (%d parte/s).\n", n.ID, sig.ID, len(parts))
+	return true
+}
+
+// emit deja una señal en el Outbox sin bloquear.
+func (n *Node) emit(sig Signal) bool {
+	select {
+	case n.Outbox <- sig:
+		return true
+	default:
+		return false
+	}
+}
+
+// feelNeighbor contagia al nodo el sufrimiento de un vecino.
+func (n *Node) feelNeighbor(amount float64) {
 	n.mu.Lock()
-	n.inRefractoryPeriod = true
-	// fmt.Printf("💤 [NODO %s] Sobrecargado. Tomando un respiro...\n", n.ID)
-	n.mu.Unlock()
+	defer n.mu.Unlock()
+
+	if n.state == StateDead || n.state == StateRetired {
+		return
+	}
+
+	// Contagio: el sufrimiento ajeno se vive como propio
+	n.Stress += amount
+	n.evaluateState()
+}
+
+// canAbsorb dice si el nodo está en condiciones de cargar estrés ajeno.
+// Un vecino ansioso (o fuera del enjambre) no puede sostener a nadie.
+func (n *Node) canAbsorb() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.state != StateDead && n.state != StateRetired && n.Stress <= n.Physiology.AnxietyThreshold
+}
+
+// absorb carga estrés en nombre de un vecino. Retorna false si ya no puede.
+func (n *Node) absorb(amount float64) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.state == StateDead || n.state == StateRetired || n.Stress > n.Physiology.AnxietyThreshold {
+		return false
+	}
+	n.Stress += amount
+	n.evaluateState()
+	return true
+}
+
+// relieve descarga hasta amount de estrés y retorna cuánto descargó de verdad.
+func (n *Node) relieve(amount float64) float64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	relieved := math.Min(n.Stress, amount)
+	n.Stress -= relieved
+	n.evaluateState()
+	return relieved
+}
+
+// CanRun dice si el nodo domina todas las especialidades que exige una tarea.
+func (n *Node) CanRun(requires []string) bool {
+	for _, req := range requires {
+		found := false
+		for _, c := range n.Capabilities {
+			if c == req {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Load es el estrés relativo a la tolerancia propia del nodo (0.0 = en calma, 1.0 = necesita respiro).
+// Un nodo robusto con estrés 40 está más holgado que uno frágil con estrés 30.
+func (n *Node) Load() float64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.load()
+}
 
-	time.Sleep(500 * time.Millisecond)
+// load calcula la carga relativa. Requiere n.mu tomado.
+func (n *Node) load() float64 {
+	if n.Physiology.BreatherThreshold <= 0 {
+		return n.Stress
+	}
+	return n.Stress / n.Physiology.BreatherThreshold
+}
+
+// vitals lee integridad, estrés y estado bajo lock.
+func (n *Node) vitals() (integrity, stress float64, state NodeState) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.Integrity, n.Stress, n.state
+}
+
+func (n *Node) takeBreather() {
+	// fmt.Printf("💤 [NODO %s] Sobrecargado. Tomando un respiro...\n", n.ID)
+	time.Sleep(time.Duration(n.Physiology.BreatherDuration))
 
 	n.mu.Lock()
 	// Al volver, el estrés baja drásticamente
-	n.Stress = math.Max(0, n.Stress-30.0)
+	n.Stress = math.Max(0, n.Stress-n.Physiology.BreatherRecovery)
 	n.inRefractoryPeriod = false
+	n.evaluateState()
+	waiting := n.deferred
+	n.deferred = nil
 	n.mu.Unlock()
 
 	// fmt.Printf("🔋 [NODO %s] Listo de nuevo.\n", n.ID)
+
+	// Las tareas de la antesala vuelven a la bandeja (si no caducaron)
+	now := time.Now()
+	for _, d := range waiting {
+		if now.After(d.expires) {
+			n.reject(d.sig, "caducó esperando el fin del respiro")
+			continue
+		}
+		select {
+		case n.Inbox <- d.sig:
+		default:
+			n.reject(d.sig, "bandeja llena al volver del respiro")
+		}
+	}
+}
+
+// report envía el desenlace de una tarea al Cortex. Espera (hasta reportTimeout)
+// antes que perderlo: un desenlace perdido deja la tarea "asignada" para siempre.
+// Completa la identidad de la tarea y del nodo; el resto lo trae r.
+// No debe llamarse con n.mu tomado.
+func (n *Node) report(sig Signal, r TaskReport) {
+	if n.Reports == nil || sig.Kind != KindTask {
+		return
+	}
+	r.SignalID, r.Task, r.NodeID = sig.ID, sig.Task, n.ID
+
+	timer := time.NewTimer(reportTimeout)
+	defer timer.Stop()
+
+	select {
+	case n.Reports <- r:
+	case <-timer.C:
+		n.mu.Lock()
+		n.lostReports++
+		n.mu.Unlock()
+		fmt.Printf("⚠️ [NODO %s] El Cortex no escuchó el desenlace de '%s' (%s).\n", n.ID, sig.ID, r.Status)
+	}
+}
+
+// reject declara explícitamente que una tarea se perdió.
+func (n *Node) reject(sig Signal, reason string) {
+	n.report(sig, TaskReport{Status: TaskRejected, Reason: reason})
 }
 
 func (n *Node) die() {
@@ -165,7 +451,7 @@ func (n *Node) die() {
 		return
 	}
 
-	n.state = StateDead
+	n.setState(StateDead)
 	n.Integrity = 0
 
 	// El grito final de muerte (Dolor máximo)
@@ -177,6 +463,105 @@ func (n *Node) die() {
 	fmt.Printf("💀 [NODO %s] HA MUERTO. Conexión perdida.\n", n.ID)
 }
 
+// Retire jubila al nodo: deja de aceptar trabajo sin que su partida duela.
+// Sus bucles (metabolismo y relevo en la malla) terminan.
+// Retorna false si el nodo ya estaba muerto o retirado.
+func (n *Node) Retire() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.state == StateDead || n.state == StateRetired {
+		return false
+	}
+	n.setState(StateRetired)
+	close(n.done)
+	return true
+}
+
+// Done se cierra cuando el nodo se jubila.
+func (n *Node) Done() <-chan struct{} {
+	return n.done
+}
+
+// drain rechaza todo lo que quedó en la bandeja.
+func (n *Node) drain(reason string) {
+	for {
+		select {
+		case sig := <-n.Inbox:
+			n.reject(sig, reason)
+		default:
+			return
+		}
+	}
+}
+
+// Alive dice si el nodo sigue en el enjambre (ni muerto ni jubilado).
+func (n *Node) Alive() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.Integrity > 0 && n.state != StateDead && n.state != StateRetired
+}
+
+// Idle dice si el nodo está en reposo total: sin estrés, sin respiro y sin trabajo pendiente.
+func (n *Node) Idle() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.Stress == 0 && !n.inRefractoryPeriod && len(n.deferred) == 0 && len(n.Inbox) == 0
+}
+
+// Regenerate es la curación natural del tejido durante un lapso de calma.
+// Un nodo ocioso se regenera más rápido y además descarga estrés.
+func (n *Node) Regenerate(dt time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	p := n.Physiology
+	if n.state == StateDead || n.state == StateRetired {
+		return
+	}
+
+	seconds := dt.Seconds()
+	rate := p.RegenRate
+	idle := !n.inRefractoryPeriod && len(n.deferred) == 0 && len(n.Inbox) == 0
+	if idle {
+		rate *= p.IdleRegenMultiplier
+		n.Stress = math.Max(0, n.Stress-p.RestRecovery*seconds)
+	}
+	defer n.evaluateState()
+
+	// Un tejido ansioso no sana
+	if n.Stress >= p.AnxietyThreshold {
+		return
+	}
+
+	n.Integrity = math.Min(n.MaxIntegrity, n.Integrity+rate*seconds)
+
+	// Se rearma el contador de roces con la muerte cuando el nodo se recupera
+	if n.nearDeath && n.Integrity > n.MaxIntegrity/2 {
+		n.nearDeath = false
+	}
+}
+
+// checkNearDeath registra un roce con la muerte. Requiere n.mu tomado.
+func (n *Node) checkNearDeath() {
+	p := n.Physiology
+	if n.nearDeath || n.Integrity >= p.NearDeathThreshold {
+		return
+	}
+
+	n.nearDeath = true
+	n.nearDeathEvents++
+
+	if n.nearDeathEvents > p.ChronicTolerance {
+		// Cicatriz permanente: el techo baja, pero nunca por debajo del umbral de peligro
+		n.MaxIntegrity = math.Max(p.NearDeathThreshold*2, n.MaxIntegrity-p.ChronicPenalty)
+		fmt.Printf("🩹 [NODO %s] Daño crónico tras %d roces con la muerte. Integridad máxima: %.0f%%\n",
+			n.ID, n.nearDeathEvents, n.MaxIntegrity)
+	}
+}
+
 // Repair repara la integridad del nodo artificialmente.
 func (n *Node) Repair(amount float64) {
 	n.mu.Lock()
@@ -188,12 +573,13 @@ func (n *Node) Repair(amount float64) {
 	}
 
 	n.Integrity += amount
-	if n.Integrity > 100.0 {
-		n.Integrity = 100.0
+	if n.Integrity > n.MaxIntegrity {
+		n.Integrity = n.MaxIntegrity
 	}
 
 	// Reparar también baja el estrés
 	n.Stress = 0
+	n.evaluateState()
 
 	fmt.Printf("✨ [MANTENIMIENTO] Nodo %s reparado. Integridad: %.1f%%\n", n.ID, n.Integrity)
 }
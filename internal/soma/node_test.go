@@ -0,0 +1,54 @@
+package soma
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func testSwarm(t *testing.T, n int) ([]*Node, chan TaskReport) {
+	t.Helper()
+
+	pain := make(chan float64, 100)
+	reports := make(chan TaskReport, 100)
+	phys := DefaultPhysiology()
+	phys.BaseLatency = Duration(time.Millisecond)
+	phys.LatencyPerStress = 0
+
+	nodes := make([]*Node, n)
+	for i := range nodes {
+		nodes[i] = NewNode(NodeSpec{ID: string(rune('A' + i))}, phys, pain, reports)
+		nodes[i].Start()
+	}
+	return nodes, reports
+}
+
+func TestRetireStopsNodeLoops(t *testing.T) {
+	nodes, _ := testSwarm(t, 3)
+	mesh, err := NewMesh(nodes, TopologyRing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mesh.Start()
+
+	time.Sleep(20 * time.Millisecond)
+	before := runtime.NumGoroutine()
+
+	if !nodes[2].Retire() {
+		t.Fatal("el nodo no se jubiló")
+	}
+	mesh.Remove(nodes[2].ID)
+
+	// Metabolismo y relevo de la malla deben terminar
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before-2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines: antes %d, ahora %d (se esperaban 2 menos)", before, runtime.NumGoroutine())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if nodes[2].Retire() {
+		t.Fatal("un nodo no se jubila dos veces")
+	}
+}
@@ -0,0 +1,192 @@
+package soma
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration es un time.Duration que se escribe como texto legible en JSON ("500ms").
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duración inválida %s: usa texto como \"500ms\"", data)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Physiology es la constitución de un nodo: cuánto le afecta el trabajo,
+// cuándo se rompe y qué tan rápido se recupera.
+type Physiology struct {
+	Name string `json:"name"`
+
+	// Estrés
+	StressPerComplexity float64 `json:"stress_per_complexity"` // Estrés por unidad de complejidad
+	AnxietyThreshold    float64 `json:"anxiety_threshold"`     // Sobre esto, el estrés hace bola de nieve
+	AnxietyMultiplier   float64 `json:"anxiety_multiplier"`    // Factor de la bola de nieve
+
+	// Daño: (Exceso ^ DamageExponent) * DamageFactor
+	DamageThreshold float64 `json:"damage_threshold"`
+	DamageExponent  float64 `json:"damage_exponent"`
+	DamageFactor    float64 `json:"damage_factor"`
+
+	// Recuperación
+	BreatherThreshold float64  `json:"breather_threshold"` // Estrés que obliga a tomar un respiro
+	BreatherDuration  Duration `json:"breather_duration"`
+	BreatherRecovery  float64  `json:"breather_recovery"` // Estrés que se descarga en el respiro
+	PassiveRecovery   float64  `json:"passive_recovery"`  // Estrés que se descarga tras cada tarea
+
+	// Latencia: BaseLatency + Estrés * LatencyPerStress
+	BaseLatency      Duration `json:"base_latency"`
+	LatencyPerStress Duration `json:"latency_per_stress"`
+
+	// Delegación y antesala
+	HandoffThreshold float64  `json:"handoff_threshold"` // Estrés a partir del cual se delega trabajo
+	SplitThreshold   float64  `json:"split_threshold"`   // Complejidad a partir de la cual una tarea se divide
+	DeferCapacity    int      `json:"defer_capacity"`    // Tareas que caben en la antesala
+	DeferTTL         Duration `json:"defer_ttl"`         // Espera máxima antes de rechazar
+
+	// Regeneración (solo en calma: estrés bajo el umbral de ansiedad)
+	RegenRate           float64 `json:"regen_rate"`            // Integridad recuperada por segundo
+	IdleRegenMultiplier float64 `json:"idle_regen_multiplier"` // Factor cuando el nodo no tiene trabajo
+	RestRecovery        float64 `json:"rest_recovery"`         // Estrés que se descarga por segundo de ocio
+
+	// Daño crónico: cada roce con la muerte más allá de la tolerancia baja el techo de integridad
+	NearDeathThreshold float64 `json:"near_death_threshold"`
+	ChronicTolerance   int     `json:"chronic_tolerance"`
+	ChronicPenalty     float64 `json:"chronic_penalty"`
+
+	// Estado clínico: Critical bajo CriticalIntegrity; la histéresis evita parpadeos entre estados
+	CriticalIntegrity float64 `json:"critical_integrity"`
+	StateHysteresis   float64 `json:"state_hysteresis"`
+}
+
+// DefaultPhysiology es la constitución original de Doloris.
+func DefaultPhysiology() Physiology {
+	return Physiology{
+		Name:                "estandar",
+		StressPerComplexity: 10.0,
+		AnxietyThreshold:    30.0,
+		AnxietyMultiplier:   1.5,
+		DamageThreshold:     50.0,
+		DamageExponent:      1.5,
+		DamageFactor:        0.05,
+		BreatherThreshold:   60.0,
+		BreatherDuration:    Duration(500 * time.Millisecond),
+		BreatherRecovery:    30.0,
+		PassiveRecovery:     5.0,
+		BaseLatency:         Duration(100 * time.Millisecond),
+		LatencyPerStress:    Duration(5 * time.Millisecond),
+		HandoffThreshold:    60.0,
+		SplitThreshold:      0.5,
+		DeferCapacity:       5,
+		DeferTTL:            Duration(3 * time.Second),
+		RegenRate:           0.2,
+		IdleRegenMultiplier: 3.0,
+		RestRecovery:        2.0,
+		NearDeathThreshold:  15.0,
+		ChronicTolerance:    2,
+		ChronicPenalty:      10.0,
+		CriticalIntegrity:   50.0,
+		StateHysteresis:     10.0,
+	}
+}
+
+// BuiltinProfiles son las constituciones de fábrica.
+// "fragil" piensa rápido pero se rompe pronto; "robusto" es lento pero aguanta.
+func BuiltinProfiles() map[string]Physiology {
+	fragile := DefaultPhysiology()
+	fragile.Name = "fragil"
+	fragile.DamageThreshold = 35.0
+	fragile.DamageFactor = 0.08
+	fragile.BreatherThreshold = 45.0
+	fragile.HandoffThreshold = 45.0
+	fragile.BaseLatency = Duration(40 * time.Millisecond)
+	fragile.LatencyPerStress = Duration(2 * time.Millisecond)
+	fragile.RegenRate = 0.4
+
+	sturdy := DefaultPhysiology()
+	sturdy.Name = "robusto"
+	sturdy.StressPerComplexity = 7.0
+	sturdy.DamageThreshold = 70.0
+	sturdy.DamageFactor = 0.03
+	sturdy.BreatherThreshold = 80.0
+	sturdy.HandoffThreshold = 80.0
+	sturdy.BaseLatency = Duration(250 * time.Millisecond)
+	sturdy.LatencyPerStress = Duration(8 * time.Millisecond)
+	sturdy.RegenRate = 0.1
+	sturdy.ChronicTolerance = 4
+
+	return map[string]Physiology{
+		"estandar": DefaultPhysiology(),
+		"fragil":   fragile,
+		"robusto":  sturdy,
+	}
+}
+
+// Validate rechaza fisiologías incoherentes: umbrales, capacidades y duraciones
+// que no son positivos, o tasas negativas.
+func (p Physiology) Validate() error {
+	positive := []struct {
+		name  string
+		value float64
+	}{
+		{"stress_per_complexity", p.StressPerComplexity},
+		{"anxiety_threshold", p.AnxietyThreshold},
+		{"anxiety_multiplier", p.AnxietyMultiplier},
+		{"damage_threshold", p.DamageThreshold},
+		{"damage_exponent", p.DamageExponent},
+		{"damage_factor", p.DamageFactor},
+		{"breather_threshold", p.BreatherThreshold},
+		{"breather_duration", float64(p.BreatherDuration)},
+		{"handoff_threshold", p.HandoffThreshold},
+		{"split_threshold", p.SplitThreshold},
+		{"defer_capacity", float64(p.DeferCapacity)},
+		{"defer_ttl", float64(p.DeferTTL)},
+		{"near_death_threshold", p.NearDeathThreshold},
+		{"critical_integrity", p.CriticalIntegrity},
+	}
+	for _, f := range positive {
+		if f.value <= 0 {
+			return fmt.Errorf("%s debe ser positivo", f.name)
+		}
+	}
+
+	nonNegative := []struct {
+		name  string
+		value float64
+	}{
+		{"breather_recovery", p.BreatherRecovery},
+		{"passive_recovery", p.PassiveRecovery},
+		{"base_latency", float64(p.BaseLatency)},
+		{"latency_per_stress", float64(p.LatencyPerStress)},
+		{"regen_rate", p.RegenRate},
+		{"idle_regen_multiplier", p.IdleRegenMultiplier},
+		{"rest_recovery", p.RestRecovery},
+		{"chronic_tolerance", float64(p.ChronicTolerance)},
+		{"chronic_penalty", p.ChronicPenalty},
+		{"state_hysteresis", p.StateHysteresis},
+	}
+	for _, f := range nonNegative {
+		if f.value < 0 {
+			return fmt.Errorf("%s no puede ser negativo", f.name)
+		}
+	}
+	return nil
+}
+
+// latency calcula cuánto tarda el nodo en pensar bajo cierto estrés.
+func (p Physiology) latency(stress float64) time.Duration {
+	return time.Duration(p.BaseLatency) + time.Duration(stress*float64(p.LatencyPerStress))
+}
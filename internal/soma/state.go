@@ -0,0 +1,164 @@
+package soma
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+func (s NodeState) String() string {
+	switch s {
+	case StateHealthy:
+		return "Healthy"
+	case StateStressed:
+		return "Stressed"
+	case StateCritical:
+		return "Critical"
+	case StateDead:
+		return "Dead"
+	case StateRetired:
+		return "Retired"
+	}
+	return fmt.Sprintf("NodeState(%d)", int(s))
+}
+
+// StateEvent es un cambio de estado clínico de un nodo.
+type StateEvent struct {
+	NodeID string
+	From   NodeState
+	To     NodeState
+	At     time.Time
+}
+
+func (e StateEvent) String() string {
+	return fmt.Sprintf("%s %s→%s", e.NodeID, e.From, e.To)
+}
+
+// EventBus reparte las transiciones de estado a quien quiera escucharlas
+// (el Cortex, los logs, una API). Un suscriptor lento pierde eventos, nunca frena al nodo.
+type EventBus struct {
+	subs []chan StateEvent
+	mu   sync.Mutex
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Subscribe abre un canal que recibirá cada transición futura.
+func (b *EventBus) Subscribe(buffer int) <-chan StateEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan StateEvent, buffer)
+	b.subs = append(b.subs, ch)
+	return ch
+}
+
+// Publish entrega el evento sin bloquear.
+func (b *EventBus) Publish(ev StateEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// State retorna el estado clínico actual del nodo.
+func (n *Node) State() NodeState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.state
+}
+
+// evaluateState recalcula el estado clínico con histéresis: para salir de un estado
+// hay que alejarse del umbral más de lo que hizo falta para entrar. Requiere n.mu tomado.
+func (n *Node) evaluateState() {
+	if n.state == StateDead || n.state == StateRetired {
+		return
+	}
+
+	p := n.Physiology
+	h := p.StateHysteresis
+
+	critical := n.Integrity < p.CriticalIntegrity
+	if n.state == StateCritical {
+		critical = n.Integrity < p.CriticalIntegrity+h
+	}
+
+	stressed := n.Stress > p.AnxietyThreshold
+	if n.state == StateStressed || n.state == StateCritical {
+		stressed = n.Stress > p.AnxietyThreshold-h
+	}
+
+	switch {
+	case critical:
+		n.setState(StateCritical)
+	case stressed:
+		n.setState(StateStressed)
+	default:
+		n.setState(StateHealthy)
+	}
+}
+
+// setState aplica una transición y la publica. Requiere n.mu tomado.
+func (n *Node) setState(to NodeState) {
+	if n.state == to {
+		return
+	}
+
+	ev := StateEvent{NodeID: n.ID, From: n.state, To: to, At: time.Now()}
+	n.state = to
+
+	if n.Events != nil {
+		n.Events.Publish(ev)
+	}
+}
+
+// NodeSnapshot es una foto inmutable del nodo, tomada bajo lock.
+// Es lo único que deberían leer la consola, la API y la persistencia.
+type NodeSnapshot struct {
+	ID           string
+	Profile      string
+	State        NodeState
+	Integrity    float64
+	MaxIntegrity float64
+	Stress       float64
+	Load         float64 // Estrés relativo a su umbral de respiro
+	Refractory   bool
+	Pending      int // Tareas en bandeja + antesala
+	LostReports  int // Desenlaces que el Cortex nunca escuchó
+	Cost         float64
+	Capabilities []string
+}
+
+// Snapshot toma una foto coherente del nodo.
+func (n *Node) Snapshot() NodeSnapshot {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return NodeSnapshot{
+		ID:           n.ID,
+		Profile:      n.Physiology.Name,
+		State:        n.state,
+		Integrity:    n.Integrity,
+		MaxIntegrity: n.MaxIntegrity,
+		Stress:       n.Stress,
+		Load:         n.load(),
+		Refractory:   n.inRefractoryPeriod,
+		Pending:      len(n.Inbox) + len(n.deferred),
+		LostReports:  n.lostReports,
+		Cost:         n.Cost,
+		Capabilities: append([]string(nil), n.Capabilities...),
+	}
+}
+
+// Alive dice si el nodo de la foto seguía en el enjambre.
+func (s NodeSnapshot) Alive() bool {
+	return s.Integrity > 0 && s.State != StateDead && s.State != StateRetired
+}
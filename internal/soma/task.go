@@ -0,0 +1,49 @@
+package soma
+
+import (
+	"strings"
+	"time"
+)
+
+// TaskStatus es el desenlace de una tarea en el cuerpo.
+type TaskStatus string
+
+const (
+	TaskAssigned TaskStatus = "asignada"   // El Cortex la dejó en la bandeja de un nodo
+	TaskDeferred TaskStatus = "diferida"   // Espera a que el nodo termine su respiro
+	TaskDone     TaskStatus = "completada" // El nodo la ejecutó (con o sin daño)
+	TaskRejected TaskStatus = "rechazada"  // Se perdió: nunca se ejecutará
+	TaskSplit    TaskStatus = "dividida"   // Un nodo la partió en dos mitades que viajan por separado
+)
+
+// SubID nombra una mitad de una tarea dividida (T-1 -> T-1a, T-1b; T-1a -> T-1aa, T-1ab).
+func SubID(id string, part int) string {
+	return id + string(rune('a'+part))
+}
+
+// RootID recupera la orden original a partir de cualquiera de sus partes (T-1ab -> T-1).
+func RootID(id string) string {
+	return strings.TrimRight(id, "ab")
+}
+
+// TaskReport es la vía aferente de resultados: el nodo le cuenta al Cortex
+// qué pasó con cada tarea que recibió.
+type TaskReport struct {
+	SignalID string
+	Task     string
+	NodeID   string
+	Status   TaskStatus
+	Reason   string
+	Damage   float64 // Integridad perdida durante la ejecución (el dolor que gritó el nodo)
+
+	// Lo que de verdad costó ejecutarla (solo en tareas completadas)
+	Stress  float64       // Estrés del nodo al ejecutarla
+	Latency time.Duration // Cuánto tardó en pensarla
+	Died    bool          // El nodo no sobrevivió
+}
+
+// deferredSignal es una tarea que espera en la antesala del nodo.
+type deferredSignal struct {
+	sig     Signal
+	expires time.Time
+}